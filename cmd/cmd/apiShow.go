@@ -51,8 +51,9 @@ var apiShowCmd = &cobra.Command{
 
 func init() {
 	apiCmd.AddCommand(apiShowCmd)
+	addListFlags(apiShowCmd)
 	apiShowCmd.SetHelpTemplate(showAPICmdLongDesc + utils.GetCmdUsage(programName, apiCmdLiteral,
-		showAPICmdLiteral, "[api-name]") + showAPICmdExamples + utils.GetCmdFlags(apiCmdLiteral))
+		showAPICmdLiteral, "[api-name]") + showAPICmdExamples + utils.GetListCmdFlags(apiCmdLiteral, &artifactUtils.APIList{}))
 }
 
 func handleAPICmdArguments(args []string) {
@@ -74,7 +75,7 @@ func handleAPICmdArguments(args []string) {
 
 func printAPIHelp() {
 	fmt.Print(showAPICmdLongDesc + utils.GetCmdUsage(programName, apiCmdLiteral, showAPICmdLiteral,
-		"[api-name]") + showAPICmdExamples + utils.GetCmdFlags(apiCmdLiteral))
+		"[api-name]") + showAPICmdExamples + utils.GetListCmdFlags(apiCmdLiteral, &artifactUtils.APIList{}))
 }
 
 func executeGetAPICmd(apiname string) {
@@ -106,7 +107,7 @@ func printAPIInfo(api artifactUtils.API) {
 
 	table := utils.GetTableWriter()
 
-	data := []string{utils.Url, utils.Method}
+	data := []string{artifactUtils.ColumnUrl, artifactUtils.ColumnMethod}
 	table.Append(data)
 
 	for _, resource := range api.Resources {
@@ -135,7 +136,7 @@ func executeListAPIsCmd() {
 	if err == nil {
 		// Printing the list of available APIs
 		list := resp.(*artifactUtils.APIList)
-		utils.PrintItemList(list, []string{utils.Name, utils.Url}, "No APIs found")
+		utils.PrintItemList(list, "No APIs found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of APIs", err)
 	}
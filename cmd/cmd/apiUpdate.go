@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+const updateAPICmdLiteral = "update"
+const updateAPICmdShortDesc = "Update statistics or tracing of an API"
+
+const updateAPICmdLongDesc = "Enable and Disable statistics or tracing of a specified API\n" +
+	"Several APIs can be named, or selected with --filter or --from-file, to update them concurrently\n"
+
+var updateAPICmdUsage = "Usage:\n" +
+	"  " + programName + " " + apiCmdLiteral + " " + updateAPICmdLiteral + " [api-name]... [" + utils.ArtifactPropertyTracing + "|" + utils.ArtifactPropertyStatistics + "] [enable|disable]\n" +
+	"  " + programName + " " + apiCmdLiteral + " " + updateAPICmdLiteral + " [" + utils.ArtifactPropertyTracing + "|" + utils.ArtifactPropertyStatistics + "] [enable|disable] --filter [expression]\n" +
+	"  " + programName + " " + apiCmdLiteral + " " + updateAPICmdLiteral + " [" + utils.ArtifactPropertyTracing + "|" + utils.ArtifactPropertyStatistics + "] [enable|disable] --from-file [file-path]\n\n"
+
+var updateAPICmdExamples = "Example:\n" +
+	"  " + programName + " " + apiCmdLiteral + " " + updateAPICmdLiteral + " OrderAPI tracing enable\n" +
+	"  " + programName + " " + apiCmdLiteral + " " + updateAPICmdLiteral + " OrderAPI statistics disable\n" +
+	"To enable tracing of the APIs listed in a file\n" +
+	"  " + programName + " " + apiCmdLiteral + " " + updateAPICmdLiteral + " tracing enable --from-file apis.txt\n\n"
+
+var updateAPICmdHelpString = updateAPICmdLongDesc + updateAPICmdUsage + updateAPICmdExamples
+
+var apiUpdateCmd = &cobra.Command{
+	Use:   updateAPICmdLiteral,
+	Short: updateAPICmdShortDesc,
+	Long:  updateAPICmdLongDesc + updateAPICmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleUpdateAPICmdArguments(args)
+	},
+}
+
+func init() {
+	apiCmd.AddCommand(apiUpdateCmd)
+	apiUpdateCmd.SetHelpTemplate(updateAPICmdHelpString +
+		utils.GetPropertyUpdateCmdFlags(updateAPICmdLiteral, &artifactUtils.APIList{}))
+	addArtifactSelectorFlags(apiUpdateCmd)
+}
+
+func handleUpdateAPICmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Update API called")
+	if utils.ContainsString(args, "help") {
+		printUpdateAPIHelp()
+	} else if names, property, value, valid := parseArtifactUpdateArgs(args, utils.ArtifactPropertyTracing,
+		utils.ArtifactPropertyStatistics); !valid {
+		printInvalidAPIUpdateCmdMessage(args)
+	} else {
+		executePropertyUpdate(apiCmdLiteral, "API", names, property, value)
+	}
+}
+
+func printInvalidAPIUpdateCmdMessage(args []string) {
+	fmt.Println("api update:", args, "is not a valid command.\n"+
+		programName, "api update requires API names, --filter or --from-file followed by tracing [enable|disable] "+
+		"or statistics [enable|disable]. See the usage below.")
+	printUpdateAPIHelp()
+}
+
+func printUpdateAPIHelp() {
+	fmt.Println(updateAPICmdHelpString)
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+var applyFile string
+
+// Apply command related usage info
+const applyCmdLiteral = "apply"
+const applyCmdShortDesc = "Bring the Micro Integrator to the state declared in a state file"
+
+const applyCmdLongDesc = "Compare the endpoint, proxy service and message processor states, logger levels and " +
+	"users declared in\nthe YAML file given by --file with the current Micro Integrator, print the plan of " +
+	"changes and make only\nthose changes. Passwords of new users are read from the environment variables named " +
+	"by passwordEnv.\nAsks for confirmation unless --yes is given when the plan removes users or deactivates " +
+	"artifacts.\nExits with status 1 when the state cannot be reached or a change fails\n"
+
+var applyCmdUsage = "Usage:\n" +
+	"  " + programName + " " + applyCmdLiteral + " -f [file-path]\n" +
+	"  " + programName + " " + applyCmdLiteral + " -f [file-path] --yes\n" +
+	"  " + programName + " " + applyCmdLiteral + " -f [file-path] --dry-run\n\n"
+
+var applyCmdExamples = "Example:\n" +
+	"To apply a state file such as\n" +
+	"  endpoints:\n" +
+	"    StockEP: inactive\n" +
+	"  proxyServices:\n" +
+	"    StockQuoteProxy: active\n" +
+	"  messageProcessors:\n" +
+	"    OrderProc: active\n" +
+	"  loggers:\n" +
+	"    root: INFO\n" +
+	"    com-example-order:\n" +
+	"      level: DEBUG\n" +
+	"      class: com.example.order\n" +
+	"  users:\n" +
+	"    ops:\n" +
+	"      admin: true\n" +
+	"      passwordEnv: OPS_PASSWORD\n" +
+	"    guest:\n" +
+	"      state: absent\n" +
+	"  " + programName + " " + applyCmdLiteral + " -f state.yaml\n\n" +
+	"To print the plan and the requests which would make the changes without sending them\n" +
+	"  " + programName + " " + applyCmdLiteral + " -f state.yaml --dry-run\n\n"
+
+var applyCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + applyCmdLiteral + "\n" +
+	"  -f, --file\t\tPath of the state file\n" +
+	"  -y, --yes\t\tSkip the confirmation prompt, needed when stdin is not a terminal\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n" +
+	"      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+
+var applyCmdHelpString = applyCmdLongDesc + applyCmdUsage + applyCmdExamples + applyCmdFlags
+
+// applyCmd represents the apply command
+var applyCmd = &cobra.Command{
+	Use:   applyCmdLiteral,
+	Short: applyCmdShortDesc,
+	Long:  applyCmdLongDesc + applyCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleApplyCmdArguments(args)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(applyCmd)
+	applyCmd.Flags().StringVarP(&applyFile, "file", "f", "", "Path of the state file")
+	addConfirmFlag(applyCmd)
+	applyCmd.SetHelpTemplate(applyCmdHelpString)
+}
+
+func handleApplyCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Apply called")
+	if len(args) == 1 && args[0] == utils.HelpCommand {
+		printApplyHelp()
+	} else if len(args) > 0 {
+		fmt.Println("Too many arguments. See the usage below")
+		printApplyHelp()
+	} else if applyFile == "" {
+		fmt.Println("Please provide the path of a state file with --file. See the usage below")
+		printApplyHelp()
+	} else {
+		executeApplyCmd(applyFile)
+	}
+}
+
+func printApplyHelp() {
+	fmt.Print(applyCmdHelpString)
+}
+
+func executeApplyCmd(stateFile string) {
+	state, err := utils.ReadDesiredState(stateFile)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the state file.", err)
+	}
+	remoteName := utils.RemoteConfigData.CurrentRemote
+	changes, errs := utils.PlanDesiredState(utils.RemoteConfigData.Remotes[remoteName], state)
+	for _, err := range errs {
+		fmt.Fprintln(os.Stderr, utils.LogPrefixError+err.Error())
+	}
+	if len(errs) > 0 {
+		utils.HandleErrorAndExit("Unable to reach the state of "+stateFile+" on "+remoteName+".", nil)
+	}
+
+	if len(changes) == 0 {
+		fmt.Println(remoteName + " is already in the state of " + stateFile + ", nothing to change")
+		return
+	}
+	fmt.Println("Plan for " + remoteName + " (+ add, - remove, ~ change):")
+	for _, change := range changes {
+		fmt.Println("  " + describeApplyChange(change))
+	}
+	fmt.Println()
+	for _, change := range changes {
+		if utils.IsReducingChange(change) {
+			utils.ConfirmCurrentRemoteOperation("apply a plan which removes users or deactivates artifacts",
+				confirmYes)
+			break
+		}
+	}
+	failed := 0
+	for _, change := range changes {
+		if err := utils.ApplyDesiredStateChange(change, state); err != nil {
+			fmt.Fprintln(os.Stderr, utils.LogPrefixError+change.Section+" "+change.Name+": "+err.Error())
+			failed++
+		} else {
+			fmt.Println(applyResultPrefix() + describeApplyChange(change))
+		}
+	}
+	if utils.DryRun {
+		fmt.Println("Dry run, " + strconv.Itoa(len(changes)-failed) + " changes were not made, " +
+			strconv.Itoa(failed) + " failed")
+	} else {
+		fmt.Println(strconv.Itoa(len(changes)-failed) + " changes applied, " + strconv.Itoa(failed) + " failed")
+	}
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// describe a change with the settings of the added loggers and users
+func describeApplyChange(change utils.InventoryChange) string {
+	if change.Kind == utils.InventoryChangeAdded && change.New != "" {
+		return change.String() + " (" + change.Property + ": " + change.New + ")"
+	}
+	return change.String()
+}
+
+// prefix of the changes which were made, or would have been made in a dry run
+func applyResultPrefix() string {
+	if utils.DryRun {
+		return "Would apply "
+	}
+	return "Applied "
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Car command related usage info
+const carCmdLiteral = "car"
+const carCmdShortDesc = "Work with Composite App archives offline"
+const carCmdLongDesc = "Inspect Composite App (.car) archives without a Micro Integrator"
+
+// carCmd represents the car command
+var carCmd = &cobra.Command{
+	Use:   carCmdLiteral,
+	Short: carCmdShortDesc,
+	Long:  carCmdLongDesc,
+}
+
+func init() {
+	RootCmd.AddCommand(carCmd)
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+const defaultCarVersion = "1.0.0"
+
+var carBuildName string
+var carBuildGroup string
+var carBuildVersion string
+var carBuildOutput string
+
+// Car build command related usage info
+const carBuildCmdLiteral = "build"
+const carBuildCmdShortDesc = "Build a Composite App archive from a Synapse artifact directory"
+
+var carBuildCmdLongDesc = "Package the Synapse configurations in the directory given by [dir-path] into a " +
+	"deployable Composite App archive.\nConfigurations are read from the sub directories " +
+	strings.Join(getSynapseDirectoryNames(), "/, ") + "/.\nartifacts.xml and the metadata of each artifact are " +
+	"generated with the given group and version\n"
+
+var carBuildCmdUsage = "Usage:\n" +
+	"  " + programName + " " + carCmdLiteral + " " + carBuildCmdLiteral + " [dir-path]\n" +
+	"  " + programName + " " + carCmdLiteral + " " + carBuildCmdLiteral +
+	" [dir-path] --name=[app-name] --group=[group-id] --version=[version] --output=[file-path]\n\n"
+
+var carBuildCmdExamples = "Example:\n" +
+	"To build OrderCApp_1.0.0.car from the order-integration directory\n" +
+	"  " + programName + " " + carCmdLiteral + " " + carBuildCmdLiteral +
+	" order-integration --name=OrderCApp --group=com.example.order\n\n" +
+	"To build a given version into a given file\n" +
+	"  " + programName + " " + carCmdLiteral + " " + carBuildCmdLiteral +
+	" order-integration --name=OrderCApp --version=1.2.0 --output=dist/OrderCApp.car\n\n"
+
+var carBuildCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + carBuildCmdLiteral + "\n" +
+	"      --name\t\tName of the Composite App (default is the name of the directory)\n" +
+	"      --group\t\tGroup id of the Composite App and its artifacts\n" +
+	"      --version\t\tVersion of the Composite App and its artifacts (default " + defaultCarVersion + ")\n" +
+	"  -o, --output\t\tPath of the archive (default is [name]_[version].car)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n"
+
+var carBuildCmdHelpString = carBuildCmdLongDesc + carBuildCmdUsage + carBuildCmdExamples + carBuildCmdFlags
+
+// carBuildCmd represents the car build command
+var carBuildCmd = &cobra.Command{
+	Use:   carBuildCmdLiteral,
+	Short: carBuildCmdShortDesc,
+	Long:  carBuildCmdLongDesc + carBuildCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleCarBuildCmdArguments(args)
+	},
+}
+
+func init() {
+	carCmd.AddCommand(carBuildCmd)
+	carBuildCmd.Flags().StringVar(&carBuildName, "name", "", "Name of the Composite App")
+	carBuildCmd.Flags().StringVar(&carBuildGroup, "group", "", "Group id of the Composite App and its artifacts")
+	carBuildCmd.Flags().StringVar(&carBuildVersion, "version", defaultCarVersion,
+		"Version of the Composite App and its artifacts")
+	carBuildCmd.Flags().StringVarP(&carBuildOutput, "output", "o", "", "Path of the archive")
+	carBuildCmd.SetHelpTemplate(carBuildCmdHelpString)
+}
+
+func getSynapseDirectoryNames() []string {
+	var names []string
+	for _, directory := range utils.SynapseDirectories {
+		names = append(names, directory.Name)
+	}
+	return names
+}
+
+func handleCarBuildCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Car build called")
+	if len(args) == 0 {
+		fmt.Println("Please provide the path of a Synapse artifact directory. See the usage below")
+		printCarBuildHelp()
+	} else if len(args) == 1 {
+		if args[0] == utils.HelpCommand {
+			printCarBuildHelp()
+		} else {
+			executeCarBuildCmd(args[0])
+		}
+	} else {
+		fmt.Println("Too many arguments. See the usage below")
+		printCarBuildHelp()
+	}
+}
+
+func printCarBuildHelp() {
+	fmt.Print(carBuildCmdHelpString)
+}
+
+func executeCarBuildCmd(dir string) {
+	name := carBuildName
+	if name == "" {
+		absolutePath, err := filepath.Abs(dir)
+		if err != nil {
+			utils.HandleErrorAndExit("Error resolving "+dir+".", err)
+		}
+		name = filepath.Base(absolutePath)
+	}
+	if strings.TrimSpace(carBuildVersion) == "" {
+		utils.HandleErrorAndExit("Invalid version.", errors.New("version cannot be empty"))
+	}
+	output := carBuildOutput
+	if output == "" {
+		output = name + "_" + carBuildVersion + ".car"
+	}
+
+	artifacts, err := utils.ReadSynapseDirectory(dir)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the Synapse artifacts.", err)
+	}
+	if len(artifacts) == 0 {
+		utils.HandleErrorAndExit("No Synapse artifacts found in "+dir+".", errors.New("expected configurations in "+
+			strings.Join(getSynapseDirectoryNames(), ", ")))
+	}
+	application := utils.CarArtifact{Name: name, GroupId: carBuildGroup, Version: carBuildVersion}
+	if err = utils.BuildCarFile(artifacts, application, output); err != nil {
+		utils.HandleErrorAndExit("Error building the Composite App archive.", err)
+	}
+	fmt.Println("Composite App " + name + " " + carBuildVersion + " with " + strconv.Itoa(len(artifacts)) +
+		" artifacts written to " + output)
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+var carDiffFormat string
+
+// Car diff command related usage info
+const carDiffCmdLiteral = "diff"
+const carDiffCmdShortDesc = "Compare two Composite App archives"
+
+const carDiffCmdLongDesc = "Report the artifacts added, removed or changed between the Composite App archives " +
+	"[old-file] and [new-file],\nalong with version changes and a diff of each changed configuration. " +
+	"Configurations are normalized before\ncomparing them, so changes in whitespace and attribute order are " +
+	"ignored. Exits with status 1 when the\narchives differ\n"
+
+var carDiffCmdUsage = "Usage:\n" +
+	"  " + programName + " " + carCmdLiteral + " " + carDiffCmdLiteral + " [old-file] [new-file]\n\n"
+
+var carDiffCmdExamples = "Example:\n" +
+	"To review the changes between two releases of a Composite App\n" +
+	"  " + programName + " " + carCmdLiteral + " " + carDiffCmdLiteral + " OrderCApp_1.0.0.car OrderCApp_1.0.1.car\n\n" +
+	"To print the changes in JSON form\n" +
+	"  " + programName + " " + carCmdLiteral + " " + carDiffCmdLiteral +
+	" OrderCApp_1.0.0.car OrderCApp_1.0.1.car --format=json\n\n"
+
+var carDiffCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + carDiffCmdLiteral + "\n" +
+	"      --format\t\tOutput format, text or json (default text)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n"
+
+var carDiffCmdHelpString = carDiffCmdLongDesc + carDiffCmdUsage + carDiffCmdExamples + carDiffCmdFlags
+
+// carDiffCmd represents the car diff command
+var carDiffCmd = &cobra.Command{
+	Use:   carDiffCmdLiteral,
+	Short: carDiffCmdShortDesc,
+	Long:  carDiffCmdLongDesc + carDiffCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleCarDiffCmdArguments(args)
+	},
+}
+
+func init() {
+	carCmd.AddCommand(carDiffCmd)
+	carDiffCmd.Flags().StringVar(&carDiffFormat, "format", utils.OutputFormatText, "Output format, text or json")
+	carDiffCmd.SetHelpTemplate(carDiffCmdHelpString)
+}
+
+func handleCarDiffCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Car diff called")
+	if len(args) == 1 && args[0] == utils.HelpCommand {
+		printCarDiffHelp()
+	} else if len(args) == 2 {
+		executeCarDiffCmd(args[0], args[1])
+	} else {
+		fmt.Println(programName, "car diff requires 2 arguments. See the usage below")
+		printCarDiffHelp()
+	}
+}
+
+func printCarDiffHelp() {
+	fmt.Print(carDiffCmdHelpString)
+}
+
+func executeCarDiffCmd(oldFilePath, newFilePath string) {
+	if carDiffFormat != utils.OutputFormatText && carDiffFormat != utils.OutputFormatJSON {
+		utils.HandleErrorAndExit("Invalid format.", errors.New("format must be "+utils.OutputFormatText+
+			" or "+utils.OutputFormatJSON))
+	}
+	oldCarFile, err := utils.ReadCarFile(oldFilePath)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the Composite App archive.", err)
+	}
+	newCarFile, err := utils.ReadCarFile(newFilePath)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the Composite App archive.", err)
+	}
+	diff, err := utils.DiffCarFiles(oldCarFile, newCarFile)
+	if err != nil {
+		utils.HandleErrorAndExit("Error comparing the Composite App archives.", err)
+	}
+
+	if carDiffFormat == utils.OutputFormatJSON {
+		// keep the markup in the configuration diffs readable
+		buffer := new(bytes.Buffer)
+		encoder := json.NewEncoder(buffer)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err = encoder.Encode(diff); err != nil {
+			utils.HandleErrorAndExit("Error formatting the differences.", err)
+		}
+		fmt.Print(buffer.String())
+	} else {
+		printCarDiff(diff)
+	}
+	if diff.Application.Kind != "" || len(diff.Changes) > 0 {
+		os.Exit(1)
+	}
+}
+
+func printCarDiff(diff utils.CarDiff) {
+	fmt.Println("Comparing " + diff.Old + " with " + diff.New)
+	if diff.Application.Kind == "" && len(diff.Changes) == 0 {
+		fmt.Println("No differences found")
+		return
+	}
+	fmt.Println()
+	if diff.Application.Kind != "" {
+		fmt.Println("~ " + diff.Application.Name + " " + diff.Application.OldVersion + " -> " +
+			diff.Application.NewVersion)
+	}
+	for _, change := range diff.Changes {
+		item := change.Type + "/" + change.Name
+		switch change.Kind {
+		case utils.InventoryChangeAdded:
+			fmt.Println("+ " + item + " " + change.NewVersion)
+		case utils.InventoryChangeRemoved:
+			fmt.Println("- " + item + " " + change.OldVersion)
+		default:
+			if change.OldVersion != change.NewVersion {
+				fmt.Println("~ " + item + " " + change.OldVersion + " -> " + change.NewVersion)
+			} else {
+				fmt.Println("~ " + item + " " + change.NewVersion)
+			}
+			for _, line := range change.Diff {
+				fmt.Println("    " + line)
+			}
+		}
+	}
+}
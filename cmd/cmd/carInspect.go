@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+var carInspectFormat string
+
+// Car inspect command related usage info
+const carInspectCmdLiteral = "inspect"
+const carInspectCmdShortDesc = "List the artifacts in a Composite App archive"
+
+const carInspectCmdLongDesc = "Open the Composite App archive given by [file-path], parse its artifacts.xml and " +
+	"the metadata of each\nartifact, and list the artifacts by type with their versions, server roles and " +
+	"dependencies\n"
+
+var carInspectCmdUsage = "Usage:\n" +
+	"  " + programName + " " + carCmdLiteral + " " + carInspectCmdLiteral + " [file-path]\n\n"
+
+var carInspectCmdExamples = "Example:\n" +
+	"To list the artifacts in a Composite App archive\n" +
+	"  " + programName + " " + carCmdLiteral + " " + carInspectCmdLiteral + " OrderCApp_1.0.0.car\n\n" +
+	"To print the content of a Composite App archive in JSON form\n" +
+	"  " + programName + " " + carCmdLiteral + " " + carInspectCmdLiteral + " OrderCApp_1.0.0.car --format=json\n\n"
+
+var carInspectCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + carInspectCmdLiteral + "\n" +
+	"      --format\t\tOutput format, table or json (default table)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n"
+
+var carInspectCmdHelpString = carInspectCmdLongDesc + carInspectCmdUsage + carInspectCmdExamples +
+	carInspectCmdFlags
+
+// carInspectCmd represents the car inspect command
+var carInspectCmd = &cobra.Command{
+	Use:   carInspectCmdLiteral,
+	Short: carInspectCmdShortDesc,
+	Long:  carInspectCmdLongDesc + carInspectCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleCarInspectCmdArguments(args)
+	},
+}
+
+func init() {
+	carCmd.AddCommand(carInspectCmd)
+	carInspectCmd.Flags().StringVar(&carInspectFormat, "format", utils.OutputFormatTable,
+		"Output format, table or json")
+	carInspectCmd.SetHelpTemplate(carInspectCmdHelpString)
+}
+
+func handleCarInspectCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Car inspect called")
+	if len(args) == 0 {
+		fmt.Println("Please provide the path of a Composite App archive. See the usage below")
+		printCarInspectHelp()
+	} else if len(args) == 1 {
+		if args[0] == utils.HelpCommand {
+			printCarInspectHelp()
+		} else {
+			executeCarInspectCmd(args[0])
+		}
+	} else {
+		fmt.Println("Too many arguments. See the usage below")
+		printCarInspectHelp()
+	}
+}
+
+func printCarInspectHelp() {
+	fmt.Print(carInspectCmdHelpString)
+}
+
+func executeCarInspectCmd(carFilePath string) {
+	if carInspectFormat != utils.OutputFormatTable && carInspectFormat != utils.OutputFormatJSON {
+		utils.HandleErrorAndExit("Invalid format.", errors.New("format must be "+utils.OutputFormatTable+
+			" or "+utils.OutputFormatJSON))
+	}
+	carFile, err := utils.ReadCarFile(carFilePath)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the Composite App archive.", err)
+	}
+	sort.SliceStable(carFile.Artifacts, func(i, j int) bool {
+		if carFile.Artifacts[i].Type != carFile.Artifacts[j].Type {
+			return carFile.Artifacts[i].Type < carFile.Artifacts[j].Type
+		}
+		return carFile.Artifacts[i].Name < carFile.Artifacts[j].Name
+	})
+
+	if carInspectFormat == utils.OutputFormatJSON {
+		data, err := json.MarshalIndent(carFile, "", "  ")
+		if err != nil {
+			utils.HandleErrorAndExit("Error formatting the Composite App archive.", err)
+		}
+		fmt.Println(string(data))
+		return
+	}
+	printCarFile(carFile)
+}
+
+func printCarFile(carFile utils.CarFile) {
+	fmt.Println("Name - " + carFile.Application.Name)
+	fmt.Println("Version - " + carFile.Application.Version)
+	fmt.Println("Artifacts :")
+	if len(carFile.Artifacts) == 0 {
+		fmt.Println("No artifacts found")
+		return
+	}
+
+	columns := []artifactUtils.Column{{Header: "TYPE"}, {Header: "NAME"}, {Header: "VERSION"},
+		{Header: "SERVER ROLE"}, {Header: "DEPENDENCIES"}}
+	var rows []artifactUtils.Row
+	var missing []string
+	for _, artifact := range carFile.Artifacts {
+		artifactType := artifact.Type
+		if artifact.Missing {
+			artifactType = "-"
+			missing = append(missing, artifact.Name+" "+artifact.Version)
+		}
+		rows = append(rows, artifactUtils.Row{Cells: []string{artifactType, artifact.Name, artifact.Version,
+			artifact.ServerRole, strings.Join(artifact.Dependencies, ", ")}})
+	}
+	err := utils.RenderTable(os.Stdout, columns, rows, utils.TableOptions{Wide: true}, utils.TableStyle{})
+	if err != nil {
+		utils.HandleErrorAndExit("Error printing the artifacts.", err)
+	}
+	if len(missing) > 0 {
+		fmt.Println("Artifacts declared in " + utils.CarArtifactsFileName + " without metadata in the archive :")
+		for _, artifact := range missing {
+			fmt.Println("  " + artifact)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+// time between two polls of the composite app lists while waiting for a deployment
+const deployPollInterval = 2 * time.Second
+
+var deployWait bool
+var deployTimeout time.Duration
+
+// Deploy composite app command related usage info
+const deployAppCmdLiteral = "deploy"
+const deployAppCmdShortDesc = "Deploy a Composite App"
+
+const deployAppCmdLongDesc = "Upload the Composite App archive given by [file-path] to the Micro Integrator. " +
+	"With --wait,\nblock until the Composite App is active, failing if it is deployed as faulty. When the same " +
+	"version\nis already deployed, --wait first waits for it to be undeployed, as the composite app lists do " +
+	"not\ntell the two deployments apart.\n--wait has no effect with --dry-run\n"
+
+var deployAppCmdUsage = "Usage:\n" +
+	"  " + programName + " " + appCmdLiteral + " " + deployAppCmdLiteral + " [file-path]\n" +
+	"  " + programName + " " + appCmdLiteral + " " + deployAppCmdLiteral + " [file-path] --wait --timeout=[duration]\n\n"
+
+var deployAppCmdExamples = "Example:\n" +
+	"To deploy a Composite App\n" +
+	"  " + programName + " " + appCmdLiteral + " " + deployAppCmdLiteral + " OrderCApp_1.0.0.car\n\n" +
+	"To deploy a Composite App and wait until it is active\n" +
+	"  " + programName + " " + appCmdLiteral + " " + deployAppCmdLiteral + " OrderCApp_1.0.0.car --wait\n\n"
+
+var deployAppCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + deployAppCmdLiteral + "\n" +
+	"      --wait\t\tWait until the Composite App is active\n" +
+	"      --timeout\t\tMaximum time to wait (default 2m0s)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n" +
+	"      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+
+var deployAppCmdHelpString = deployAppCmdLongDesc + deployAppCmdUsage + deployAppCmdExamples + deployAppCmdFlags
+
+// compositeAppDeployCmd represents the deploy composite app command
+var compositeAppDeployCmd = &cobra.Command{
+	Use:   deployAppCmdLiteral,
+	Short: deployAppCmdShortDesc,
+	Long:  deployAppCmdLongDesc + deployAppCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleDeployAppCmdArguments(args)
+	},
+}
+
+func init() {
+	compositeAppCmd.AddCommand(compositeAppDeployCmd)
+	compositeAppDeployCmd.Flags().BoolVar(&deployWait, "wait", false, "Wait until the Composite App is active")
+	compositeAppDeployCmd.Flags().DurationVar(&deployTimeout, "timeout", 2*time.Minute, "Maximum time to wait")
+	compositeAppDeployCmd.SetHelpTemplate(deployAppCmdHelpString)
+}
+
+func handleDeployAppCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Deploy composite app called")
+	if len(args) == 0 {
+		fmt.Println("Please provide the path of a Composite App archive. See the usage below")
+		printDeployAppHelp()
+	} else if len(args) == 1 {
+		if args[0] == utils.HelpCommand {
+			printDeployAppHelp()
+		} else {
+			executeDeployAppCmd(args[0])
+		}
+	} else {
+		fmt.Println("Too many arguments. See the usage below")
+		printDeployAppHelp()
+	}
+}
+
+func printDeployAppHelp() {
+	fmt.Print(deployAppCmdHelpString)
+}
+
+func executeDeployAppCmd(carFilePath string) {
+	if !utils.IsFileExist(carFilePath) {
+		utils.HandleErrorAndExit("Error deploying the Composite App.", errors.New("no such file: "+carFilePath))
+	}
+	application, err := utils.ReadCarApplication(carFilePath)
+	if err != nil {
+		utils.HandleErrorAndExit("Error deploying the Composite App.", err)
+	}
+
+	redeploying := false
+	if deployWait && !utils.DryRun {
+		redeploying = isCompositeAppDeployed(application)
+	}
+
+	resp, err := utils.DeployMICompositeApp(carFilePath)
+	if err != nil {
+		utils.HandleErrorAndExit("Error deploying the Composite App "+application.Name+".", err)
+	}
+	fmt.Println(resp)
+
+	if deployWait && !utils.DryRun {
+		waitForCompositeApp(application, redeploying)
+	}
+}
+
+// Check whether the given version of the composite app is already deployed, active or faulty, before uploading it
+// @param application : composite app declared in the archive to deploy
+// @return true if the composite app is listed by the current remote
+func isCompositeAppDeployed(application utils.CarArtifact) bool {
+	remote := utils.RemoteConfigData.Remotes[utils.RemoteConfigData.CurrentRemote]
+	compositeAppType, _ := utils.GetArtifactType(appCmdLiteral)
+	list, err := utils.FetchArtifactList(remote, compositeAppType)
+	if err != nil {
+		utils.Logln(utils.LogPrefixWarning+"Fetching composite app list failed:", err)
+		return false
+	}
+	compositeApps := list.(*artifactUtils.CompositeAppList)
+	return containsCompositeApp(compositeApps.ActiveCompositeApps, application) ||
+		containsCompositeApp(compositeApps.FaultyCompositeApps, application)
+}
+
+// Poll the composite app lists until the given version of the composite app is active or faulty
+// @param application : composite app declared in the deployed archive
+// @param redeploying : the composite app was listed before the upload, so the old deployment has to disappear
+// from the lists before the new one can be told from it
+func waitForCompositeApp(application utils.CarArtifact, redeploying bool) {
+	remote := utils.RemoteConfigData.Remotes[utils.RemoteConfigData.CurrentRemote]
+	compositeAppType, _ := utils.GetArtifactType(appCmdLiteral)
+	description := application.Name + " " + application.Version
+	deadline := time.Now().Add(deployTimeout)
+	var lastErr error
+
+	for {
+		list, err := utils.FetchArtifactList(remote, compositeAppType)
+		lastErr = err
+		if err != nil {
+			utils.Logln(utils.LogPrefixWarning+"Fetching composite app list failed:", err)
+		} else {
+			compositeApps := list.(*artifactUtils.CompositeAppList)
+			faulty := containsCompositeApp(compositeApps.FaultyCompositeApps, application)
+			active := containsCompositeApp(compositeApps.ActiveCompositeApps, application)
+			if redeploying {
+				if !faulty && !active {
+					utils.Logln(utils.LogPrefixInfo + "Composite App " + description + " was undeployed for the redeployment")
+					redeploying = false
+				}
+			} else if faulty {
+				utils.HandleErrorAndExit("Composite App "+description+" was deployed as faulty.", nil)
+			} else if active {
+				fmt.Println("Composite App " + description + " is active")
+				return
+			}
+		}
+		if !time.Now().Add(deployPollInterval).Before(deadline) {
+			break
+		}
+		time.Sleep(deployPollInterval)
+	}
+	if redeploying {
+		utils.HandleErrorAndExit("Timed out after "+deployTimeout.String()+" waiting for the deployed Composite App "+
+			description+" to be undeployed for the redeployment.", lastErr)
+	}
+	utils.HandleErrorAndExit("Timed out after "+deployTimeout.String()+" waiting for Composite App "+description+
+		" to become active.", lastErr)
+}
+
+func containsCompositeApp(compositeApps []artifactUtils.CompositeAppSummary, application utils.CarArtifact) bool {
+	for _, compositeApp := range compositeApps {
+		if compositeApp.Name == application.Name &&
+			(application.Version == "" || compositeApp.Version == application.Version) {
+			return true
+		}
+	}
+	return false
+}
@@ -53,8 +53,9 @@ var carbonAppShowCmd = &cobra.Command{
 
 func init() {
 	compositeAppCmd.AddCommand(carbonAppShowCmd)
+	addListFlags(carbonAppShowCmd)
 	carbonAppShowCmd.SetHelpTemplate(showApplicationCmdLongDesc + utils.GetCmdUsage(programName, appCmdLiteral,
-		showApplicationCmdLiteral, "[app-name]") + showApplicationCmdExamples + utils.GetCmdFlags(appCmdLiteral))
+		showApplicationCmdLiteral, "[app-name]") + showApplicationCmdExamples + utils.GetListCmdFlags(appCmdLiteral, &artifactUtils.CompositeAppList{}))
 }
 
 func handleApplicationCmdArguments(args []string) {
@@ -76,7 +77,7 @@ func handleApplicationCmdArguments(args []string) {
 
 func printAppHelp() {
 	fmt.Print(showApplicationCmdLongDesc + utils.GetCmdUsage(programName, appCmdLiteral, showApplicationCmdLiteral,
-		"[app-name]") + showApplicationCmdExamples + utils.GetCmdFlags(appCmdLiteral))
+		"[app-name]") + showApplicationCmdExamples + utils.GetListCmdFlags(appCmdLiteral, &artifactUtils.CompositeAppList{}))
 }
 
 func executeGetCarbonAppCmd(appname string) {
@@ -127,7 +128,7 @@ func executeListCarbonAppsCmd() {
 	if err == nil {
 		// Printing the list of available Carbon apps
 		list := resp.(*artifactUtils.CompositeAppList)
-		utils.PrintItemList(list, []string{utils.Name, utils.Version}, "No Composite Apps found")
+		utils.PrintItemList(list, "No Composite Apps found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Carbon apps", err)
 	}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+// Undeploy composite app command related usage info
+const undeployAppCmdLiteral = "undeploy"
+const undeployAppCmdShortDesc = "Undeploy a Composite App"
+
+const undeployAppCmdLongDesc = "Remove the Composite App specified by the command line argument [app-name] " +
+	"from the Micro Integrator.\nAsks for confirmation unless --yes is given\n"
+
+var undeployAppCmdUsage = "Usage:\n" +
+	"  " + programName + " " + appCmdLiteral + " " + undeployAppCmdLiteral + " [app-name]\n\n"
+
+var undeployAppCmdExamples = "Example:\n" +
+	"To undeploy a Composite App\n" +
+	"  " + programName + " " + appCmdLiteral + " " + undeployAppCmdLiteral + " OrderCApp\n" +
+	"  " + programName + " " + appCmdLiteral + " " + undeployAppCmdLiteral + " OrderCApp --yes\n\n"
+
+// compositeAppUndeployCmd represents the undeploy composite app command
+var compositeAppUndeployCmd = &cobra.Command{
+	Use:   undeployAppCmdLiteral,
+	Short: undeployAppCmdShortDesc,
+	Long:  undeployAppCmdLongDesc + undeployAppCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleUndeployAppCmdArguments(args)
+	},
+}
+
+func init() {
+	compositeAppCmd.AddCommand(compositeAppUndeployCmd)
+	addConfirmFlag(compositeAppUndeployCmd)
+	compositeAppUndeployCmd.SetHelpTemplate(undeployAppCmdLongDesc + undeployAppCmdUsage + undeployAppCmdExamples +
+		utils.GetConfirmCmdFlags(undeployAppCmdLiteral))
+}
+
+func handleUndeployAppCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Undeploy composite app called")
+	if len(args) == 0 {
+		fmt.Println("Please provide the name of a Composite App. See the usage below")
+		printUndeployAppHelp()
+	} else if len(args) == 1 {
+		if args[0] == utils.HelpCommand {
+			printUndeployAppHelp()
+		} else {
+			executeUndeployAppCmd(args[0])
+		}
+	} else {
+		fmt.Println("Too many arguments. See the usage below")
+		printUndeployAppHelp()
+	}
+}
+
+func printUndeployAppHelp() {
+	fmt.Print(undeployAppCmdLongDesc + undeployAppCmdUsage + undeployAppCmdExamples +
+		utils.GetConfirmCmdFlags(undeployAppCmdLiteral))
+}
+
+func executeUndeployAppCmd(appName string) {
+	utils.ConfirmCurrentRemoteOperation("undeploy Composite App "+appName, confirmYes)
+	resp, err := utils.UndeployMICompositeApp(appName)
+	if err != nil {
+		utils.HandleErrorAndExit("Error undeploying the Composite App "+appName+".", err)
+	}
+	fmt.Println(resp)
+}
@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+var verifyRemote string
+var verifyFormat string
+
+type verificationReport struct {
+	File     string                     `json:"file"`
+	Remote   string                     `json:"remote"`
+	Verified bool                       `json:"verified"`
+	Results  []utils.VerificationResult `json:"results"`
+}
+
+// Verify composite app command related usage info
+const verifyAppCmdLiteral = "verify"
+const verifyAppCmdShortDesc = "Verify that the artifacts of a Composite App archive are live"
+
+const verifyAppCmdLongDesc = "Cross-check the Composite App archive given by [file-path] against a Micro " +
+	"Integrator. The Composite App\nmust be active with the same version, and each of its artifacts must be " +
+	"listed by the management API\nand active if it has a state. Artifacts whose detail holds a version, such as " +
+	"APIs, must be of the\nsame version. Exits with status 1 when an artifact is " +
+	"missing, faulty, inactive or\nof a different version\n"
+
+var verifyAppCmdUsage = "Usage:\n" +
+	"  " + programName + " " + appCmdLiteral + " " + verifyAppCmdLiteral + " [file-path]\n" +
+	"  " + programName + " " + appCmdLiteral + " " + verifyAppCmdLiteral + " [file-path] --remote=[remote-name]\n\n"
+
+var verifyAppCmdExamples = "Example:\n" +
+	"To verify a Composite App on the current Micro Integrator\n" +
+	"  " + programName + " " + appCmdLiteral + " " + verifyAppCmdLiteral + " OrderCApp_1.0.0.car\n\n" +
+	"To verify a Composite App on another Micro Integrator in JSON form\n" +
+	"  " + programName + " " + appCmdLiteral + " " + verifyAppCmdLiteral +
+	" OrderCApp_1.0.0.car --remote=node2 --format=json\n\n"
+
+var verifyAppCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + verifyAppCmdLiteral + "\n" +
+	"      --remote\t\tRemote to verify against (default is the current remote)\n" +
+	"      --format\t\tOutput format, table or json (default table)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n" +
+	"      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+
+var verifyAppCmdHelpString = verifyAppCmdLongDesc + verifyAppCmdUsage + verifyAppCmdExamples + verifyAppCmdFlags
+
+// compositeAppVerifyCmd represents the verify composite app command
+var compositeAppVerifyCmd = &cobra.Command{
+	Use:   verifyAppCmdLiteral,
+	Short: verifyAppCmdShortDesc,
+	Long:  verifyAppCmdLongDesc + verifyAppCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleVerifyAppCmdArguments(args)
+	},
+}
+
+func init() {
+	compositeAppCmd.AddCommand(compositeAppVerifyCmd)
+	compositeAppVerifyCmd.Flags().StringVar(&verifyRemote, "remote", "", "Remote to verify against")
+	compositeAppVerifyCmd.Flags().StringVar(&verifyFormat, "format", utils.OutputFormatTable,
+		"Output format, table or json")
+	compositeAppVerifyCmd.SetHelpTemplate(verifyAppCmdHelpString)
+}
+
+func handleVerifyAppCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Verify composite app called")
+	if len(args) == 0 {
+		fmt.Println("Please provide the path of a Composite App archive. See the usage below")
+		printVerifyAppHelp()
+	} else if len(args) == 1 {
+		if args[0] == utils.HelpCommand {
+			printVerifyAppHelp()
+		} else {
+			executeVerifyAppCmd(args[0])
+		}
+	} else {
+		fmt.Println("Too many arguments. See the usage below")
+		printVerifyAppHelp()
+	}
+}
+
+func printVerifyAppHelp() {
+	fmt.Print(verifyAppCmdHelpString)
+}
+
+func executeVerifyAppCmd(carFilePath string) {
+	if verifyFormat != utils.OutputFormatTable && verifyFormat != utils.OutputFormatJSON {
+		utils.HandleErrorAndExit("Invalid format.", errors.New("format must be "+utils.OutputFormatTable+
+			" or "+utils.OutputFormatJSON))
+	}
+	remoteName := verifyRemote
+	if remoteName == "" {
+		remoteName = utils.RemoteConfigData.CurrentRemote
+	}
+	remote, exists := utils.RemoteConfigData.Remotes[remoteName]
+	if !exists {
+		utils.HandleErrorAndExit("Error: ", errors.New("no such remote: "+remoteName))
+	}
+	carFile, err := utils.ReadCarFile(carFilePath)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the Composite App archive.", err)
+	}
+
+	// fetch the composite apps along with the lists of the artifact types in the archive
+	compositeAppType, _ := utils.GetArtifactType(appCmdLiteral)
+	artifactTypes := []utils.ArtifactType{compositeAppType}
+	for _, artifact := range carFile.Artifacts {
+		artifactType, found := utils.GetArtifactTypeOfCarType(artifact.Type)
+		if found && !containsArtifactType(artifactTypes, artifactType) {
+			artifactTypes = append(artifactTypes, artifactType)
+		}
+	}
+	lists := make(map[string]artifactUtils.Table)
+	for _, result := range utils.FetchArtifactLists(remote, artifactTypes) {
+		if result.Err != nil {
+			utils.HandleErrorAndExit("Error fetching the "+result.ArtifactType.Name+" list from "+remoteName+".",
+				result.Err)
+		}
+		lists[result.ArtifactType.Name] = result.List
+	}
+
+	application := utils.VerifyCarApplication(carFile.Application,
+		lists[compositeAppType.Name].(*artifactUtils.CompositeAppList))
+	var deployed *artifactUtils.CompositeApp
+	if application.Status == utils.VerifyStatusOK {
+		deployed = &artifactUtils.CompositeApp{}
+		params := map[string]string{"carbonAppName": carFile.Application.Name}
+		if err = utils.FetchRemoteData(remote, utils.PrefixCarbonApps, params, deployed); err != nil {
+			utils.HandleErrorAndExit("Error fetching the Composite App "+carFile.Application.Name+" from "+
+				remoteName+".", err)
+		}
+	}
+
+	details, err := utils.FetchCarArtifactDetails(remote, carFile.Artifacts, lists)
+	if err != nil {
+		utils.HandleErrorAndExit("Error fetching the artifacts of "+carFile.Application.Name+" from "+
+			remoteName+".", err)
+	}
+	deployment := utils.CarDeployment{Lists: lists, Application: application, CompositeApp: deployed,
+		Details: details}
+
+	report := verificationReport{File: carFilePath, Remote: remoteName, Verified: !application.IsFailure(),
+		Results: []utils.VerificationResult{application}}
+	for _, artifact := range carFile.Artifacts {
+		result := utils.VerifyCarArtifact(artifact, deployment)
+		report.Verified = report.Verified && !result.IsFailure()
+		report.Results = append(report.Results, result)
+	}
+
+	if verifyFormat == utils.OutputFormatJSON {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			utils.HandleErrorAndExit("Error formatting the verification report.", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		printVerificationReport(report)
+	}
+	if !report.Verified {
+		os.Exit(1)
+	}
+}
+
+func containsArtifactType(artifactTypes []utils.ArtifactType, artifactType utils.ArtifactType) bool {
+	for _, existing := range artifactTypes {
+		if existing.Name == artifactType.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func printVerificationReport(report verificationReport) {
+	columns := []artifactUtils.Column{{Header: "TYPE"}, {Header: "NAME"}, {Header: "VERSION"},
+		{Header: "STATUS", Status: true}, {Header: "DETAIL"}}
+	var rows []artifactUtils.Row
+	failures := 0
+	for _, result := range report.Results {
+		row := artifactUtils.Row{Cells: []string{result.Type, result.Name, result.Version, result.Status,
+			result.Detail}}
+		if result.IsFailure() {
+			row.State = artifactUtils.RowStateError
+			failures++
+		} else if result.Status == utils.VerifyStatusNotVerified {
+			row.State = artifactUtils.RowStateWarning
+		}
+		rows = append(rows, row)
+	}
+	fmt.Println("Verifying " + report.File + " on " + report.Remote)
+	err := utils.RenderTable(os.Stdout, columns, rows, utils.TableOptions{Wide: true},
+		utils.TableStyle{Colorize: utils.IsTerminal(os.Stdout)})
+	if err != nil {
+		utils.HandleErrorAndExit("Error printing the verification report.", err)
+	}
+	if report.Verified {
+		fmt.Println("All the artifacts are live")
+	} else {
+		fmt.Println(strconv.Itoa(failures) + " of " + strconv.Itoa(len(report.Results)) + " artifacts are not live")
+	}
+}
@@ -49,8 +49,9 @@ var dataServiceInfoCmd = &cobra.Command{
 
 func init() {
 	dataServiceCmd.AddCommand(dataServiceInfoCmd)
+	addListFlags(dataServiceInfoCmd)
 	dataServiceCmd.SetHelpTemplate(showDataServiceCmdLongDesc + utils.GetCmdUsage(programName, dataServicesCmdLiteral,
-		showDataServiceCmdLiteral, "[dataservice-name]") + showDataServiceCmdExmaples + utils.GetCmdFlags(dataServicesCmdLiteral))
+		showDataServiceCmdLiteral, "[dataservice-name]") + showDataServiceCmdExmaples + utils.GetListCmdFlags(dataServicesCmdLiteral, &artifactUtils.DataServicesList{}))
 }
 
 func handleDataServiceCmdArguments(args []string) {
@@ -77,7 +78,7 @@ func executeDataServiceListCmd() {
 	if err == nil {
 		// print the list of available data services
 		list := resp.(*artifactUtils.DataServicesList)
-		utils.PrintItemList(list, []string{utils.Name, utils.Wsdl11, utils.Wsdl20}, "No dataservices found")
+		utils.PrintItemList(list, "No dataservices found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Dataservices", err)
 	}
@@ -99,7 +100,7 @@ func executeGetDataServiceCmd(dataServiceName string) {
 
 func printShowDataServiceHelp() {
 	fmt.Println(showDataServiceCmdLongDesc + utils.GetCmdUsage(programName, dataServicesCmdLiteral, showDataServiceCmdLiteral,
-		"[data-service-name]") + showDataServiceCmdExmaples + utils.GetCmdFlags(dataServicesCmdLiteral))
+		"[data-service-name]") + showDataServiceCmdExmaples + utils.GetListCmdFlags(dataServicesCmdLiteral, &artifactUtils.DataServicesList{}))
 }
 
 func printDataServiceInfo(dataServiceInfo artifactUtils.DataServiceInfo) {
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+const debugSessionTimeFormat = "2006-01-02 15:04:05 MST"
+
+// Debug session command related usage info
+const debugSessionCmdLiteral = "debug-session"
+const debugSessionCmdShortDesc = "Change log levels and tracing for a limited time"
+const debugSessionCmdLongDesc = "Raise log levels and enable tracing of artifacts for a limited time. The original " +
+	"levels and tracing\nare recorded and restored when the session is stopped, or when its duration elapses while " +
+	"the start\ncommand waits. A detached session is not reverted unless stop or revert-expired is run, such as " +
+	"by\ncron"
+
+// debugSessionCmd represents the debug-session command
+var debugSessionCmd = &cobra.Command{
+	Use:   debugSessionCmdLiteral,
+	Short: debugSessionCmdShortDesc,
+	Long:  debugSessionCmdLongDesc,
+}
+
+func init() {
+	RootCmd.AddCommand(debugSessionCmd)
+}
+
+// Revert a debug session, keeping the changes which could not be reverted in the session so that the next revert
+// retries them. Errors are printed to stderr. The session file is not changed in a dry run.
+// @param session : session to revert
+// @return true if every change was reverted
+func revertDebugSession(session utils.DebugSession) bool {
+	remaining := utils.DebugSession{Remote: session.Remote}
+	success := true
+	if remote, exists := utils.RemoteConfigData.Remotes[session.Remote]; exists {
+		var errs []error
+		remaining, errs = utils.RevertDebugSession(remote, session)
+		for _, err := range errs {
+			fmt.Fprintln(os.Stderr, utils.LogPrefixError+err.Error())
+		}
+		success = len(errs) == 0
+	} else {
+		fmt.Fprintln(os.Stderr, utils.LogPrefixWarning+"Remote "+session.Remote+" no longer exists, its debug "+
+			"session is dropped without reverting it")
+	}
+	if utils.DryRun {
+		return success
+	}
+
+	filePath := utils.GetDebugSessionFilePath()
+	sessions, err := utils.LoadDebugSessions(filePath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, utils.LogPrefixError+"Reading the debug sessions failed: "+err.Error())
+		return false
+	}
+	// the session may have been replaced meanwhile, such as by a stop and a start from another shell
+	if current, found := sessions.Get(session.Remote); found && current.Started.Equal(session.Started) {
+		sessions.Put(remaining)
+		if err = sessions.Save(filePath); err != nil {
+			fmt.Fprintln(os.Stderr, utils.LogPrefixError+"Saving the debug sessions failed: "+err.Error())
+			return false
+		}
+	}
+	return success
+}
+
+// Print the changes of a debug session with the values they revert to
+func printDebugSession(session utils.DebugSession) {
+	remaining := time.Until(session.Expires).Round(time.Second)
+	if remaining > 0 {
+		fmt.Println("Debug session of remote " + session.Remote + " until " +
+			session.Expires.Format(debugSessionTimeFormat) + " (" + remaining.String() + " left)")
+	} else {
+		fmt.Println("Debug session of remote " + session.Remote + " expired at " +
+			session.Expires.Format(debugSessionTimeFormat))
+	}
+	for _, logger := range session.Loggers {
+		name := logger.Name
+		if logger.Added {
+			name += " (added for " + logger.Class + ")"
+		}
+		fmt.Println("  Logger " + name + " : " + logger.Level + ", reverts to " + logger.OriginalLevel)
+	}
+	for _, artifact := range session.Artifacts {
+		fmt.Println("  Tracing of " + artifact.Type + "/" + artifact.Name + " : " + utils.PropertyValueEnable +
+			", reverts to " + artifact.OriginalTracing)
+	}
+}
+
+// count the changes of a debug session in a message, such as 3 changes
+func countDebugSessionChanges(session utils.DebugSession) string {
+	count := len(session.Loggers) + len(session.Artifacts)
+	if count == 1 {
+		return "1 change"
+	}
+	return strconv.Itoa(count) + " changes"
+}
@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+// Revert expired debug sessions command related usage info
+const revertExpiredDebugSessionCmdLiteral = "revert-expired"
+const revertExpiredDebugSessionCmdShortDesc = "Revert the debug sessions whose duration elapsed"
+
+var revertExpiredDebugSessionCmdLongDesc = "Revert the log levels and tracing changed by the debug sessions of " +
+	"all the remotes whose duration\nelapsed, such as detached sessions or sessions whose start command was " +
+	"killed. Detached sessions\nare not reverted otherwise, so run this command regularly, such as every minute " +
+	"by cron. Changes\nwhich cannot be reverted are kept in the session to retry them. Exits with status 1 when " +
+	"a change\ncannot be reverted.\nThe changes are reverted with the access token stored by the last login to " +
+	"each remote, so they\nfail with 401 Unauthorized once that token expires, until '" + programName + " " +
+	remoteCmdLiteral + " " + loginCmdLiteral + "' is run again.\nEvery other command warns about the expired " +
+	"sessions, reading only the local session file\n"
+
+var revertExpiredDebugSessionCmdExamples = "Example:\n" +
+	"  " + programName + " " + debugSessionCmdLiteral + " " + revertExpiredDebugSessionCmdLiteral + "\n" +
+	"To revert the expired sessions every minute, add a crontab entry such as\n" +
+	"  * * * * * " + programName + " " + debugSessionCmdLiteral + " " + revertExpiredDebugSessionCmdLiteral + "\n\n"
+
+var revertExpiredDebugSessionCmdHelpString = revertExpiredDebugSessionCmdLongDesc +
+	utils.GetCmdUsageForNonArguments(programName, debugSessionCmdLiteral, revertExpiredDebugSessionCmdLiteral) +
+	revertExpiredDebugSessionCmdExamples + utils.GetCmdFlags(revertExpiredDebugSessionCmdLiteral)
+
+var debugSessionRevertExpiredCmd = &cobra.Command{
+	Use:   revertExpiredDebugSessionCmdLiteral,
+	Short: revertExpiredDebugSessionCmdShortDesc,
+	Long:  revertExpiredDebugSessionCmdLongDesc + revertExpiredDebugSessionCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleRevertExpiredDebugSessionCmdArguments(args)
+	},
+}
+
+func init() {
+	debugSessionCmd.AddCommand(debugSessionRevertExpiredCmd)
+	debugSessionRevertExpiredCmd.SetHelpTemplate(revertExpiredDebugSessionCmdHelpString)
+}
+
+func handleRevertExpiredDebugSessionCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Revert expired debug sessions called")
+	if len(args) == 1 && args[0] == utils.HelpCommand {
+		printRevertExpiredDebugSessionHelp()
+	} else if len(args) > 0 {
+		fmt.Println("Too many arguments. See the usage below")
+		printRevertExpiredDebugSessionHelp()
+	} else {
+		executeRevertExpiredDebugSessionCmd()
+	}
+}
+
+func printRevertExpiredDebugSessionHelp() {
+	fmt.Print(revertExpiredDebugSessionCmdHelpString)
+}
+
+func executeRevertExpiredDebugSessionCmd() {
+	sessions, err := utils.LoadDebugSessions(utils.GetDebugSessionFilePath())
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the debug sessions.", err)
+	}
+	reverted, failed := 0, 0
+	for _, session := range sessions.Sessions {
+		if time.Now().Before(session.Expires) {
+			continue
+		}
+		fmt.Println("Reverting " + countDebugSessionChanges(session) + " of the debug session of remote " +
+			session.Remote + " which expired at " + session.Expires.Format(debugSessionTimeFormat))
+		if revertDebugSession(session) {
+			reverted++
+		} else {
+			failed++
+		}
+	}
+	if reverted == 0 && failed == 0 {
+		fmt.Println("No debug session has expired")
+		return
+	}
+	if utils.DryRun {
+		fmt.Println("Dry run, the debug sessions were not reverted")
+	}
+	if failed > 0 {
+		utils.HandleErrorAndExit("Unable to revert all the changes of "+countDebugSessions(failed)+
+			", they are retried by the next run.", nil)
+	}
+}
+
+// count debug sessions in a message, such as 2 debug sessions
+func countDebugSessions(count int) string {
+	if count == 1 {
+		return "1 debug session"
+	}
+	return strconv.Itoa(count) + " debug sessions"
+}
+
+// warn about the debug sessions whose duration elapsed without being reverted, such as detached sessions. Only the
+// local session file is read, and nothing is reported when it cannot be read
+func warnExpiredDebugSessions() {
+	sessions, err := utils.LoadDebugSessions(filepath.Join(utils.ConfigDirPath, utils.DebugSessionFileName))
+	if err != nil {
+		return
+	}
+	for _, session := range sessions.Sessions {
+		if time.Now().Before(session.Expires) {
+			continue
+		}
+		fmt.Fprintln(os.Stderr, utils.LogPrefixWarning+"The debug session of remote "+session.Remote+
+			" expired at "+session.Expires.Format(debugSessionTimeFormat)+" but is not reverted. Run '"+
+			programName+" "+debugSessionCmdLiteral+" "+revertExpiredDebugSessionCmdLiteral+"' to revert it")
+	}
+}
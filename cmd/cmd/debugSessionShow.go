@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+// Show debug session command related usage info
+const showDebugSessionCmdLiteral = "show"
+const showDebugSessionCmdShortDesc = "Show the active debug sessions"
+
+const showDebugSessionCmdLongDesc = "Show the debug sessions of all the remotes, with the log levels and " +
+	"tracing they changed and the\nvalues they revert to\n"
+
+var showDebugSessionCmdExamples = "Example:\n" +
+	"  " + programName + " " + debugSessionCmdLiteral + " " + showDebugSessionCmdLiteral + "\n\n"
+
+var debugSessionShowCmd = &cobra.Command{
+	Use:   showDebugSessionCmdLiteral,
+	Short: showDebugSessionCmdShortDesc,
+	Long:  showDebugSessionCmdLongDesc + showDebugSessionCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleShowDebugSessionCmdArguments(args)
+	},
+}
+
+func init() {
+	debugSessionCmd.AddCommand(debugSessionShowCmd)
+	debugSessionShowCmd.SetHelpTemplate(showDebugSessionCmdLongDesc + utils.GetCmdUsageForNonArguments(programName,
+		debugSessionCmdLiteral, showDebugSessionCmdLiteral) + showDebugSessionCmdExamples +
+		utils.GetOfflineCmdFlags(debugSessionCmdLiteral))
+}
+
+func handleShowDebugSessionCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Show debug session called")
+	if len(args) == 1 && args[0] == utils.HelpCommand {
+		printShowDebugSessionHelp()
+	} else if len(args) > 0 {
+		fmt.Println("Too many arguments. See the usage below")
+		printShowDebugSessionHelp()
+	} else {
+		executeShowDebugSessionCmd()
+	}
+}
+
+func printShowDebugSessionHelp() {
+	fmt.Print(showDebugSessionCmdLongDesc + utils.GetCmdUsageForNonArguments(programName, debugSessionCmdLiteral,
+		showDebugSessionCmdLiteral) + showDebugSessionCmdExamples + utils.GetOfflineCmdFlags(debugSessionCmdLiteral))
+}
+
+func executeShowDebugSessionCmd() {
+	sessions, err := utils.LoadDebugSessions(utils.GetDebugSessionFilePath())
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the debug sessions.", err)
+	}
+	if len(sessions.Sessions) == 0 {
+		fmt.Println("No debug sessions are active")
+		return
+	}
+	for _, session := range sessions.Sessions {
+		printDebugSession(session)
+	}
+}
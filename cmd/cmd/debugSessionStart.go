@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+var debugSessionLoggers []string
+var debugSessionTraces []string
+var debugSessionDuration time.Duration
+var debugSessionDetach bool
+
+// Start debug session command related usage info
+const startDebugSessionCmdLiteral = "start"
+const startDebugSessionCmdShortDesc = "Start a debug session"
+
+var startDebugSessionCmdLongDesc = "Set the levels of the given loggers and enable tracing of the given " +
+	"artifacts of the current remote\nfor the given duration. Loggers are given by name or class, and a class " +
+	"without a logger of its own\nis added as a logger. The original levels and tracing are recorded before they " +
+	"are changed.\nThe command waits until the duration elapses and then reverts the changes, or reverts them " +
+	"at once\nwhen interrupted with Ctrl+C. With --detach it returns at once, and the changes are NOT reverted " +
+	"when\nthe duration elapses unless '" + programName + " " + debugSessionCmdLiteral + " " +
+	stopDebugSessionCmdLiteral + "' or '" + programName + " " + debugSessionCmdLiteral + " " +
+	revertExpiredDebugSessionCmdLiteral + "'\nis run, such as by cron. The changes are reverted with the access " +
+	"token stored by the last login to\nthe remote, so reverting fails with 401 Unauthorized once that token " +
+	"expires,\nuntil '" + programName + " " + remoteCmdLiteral + " " + loginCmdLiteral + "' is run again\n"
+
+var startDebugSessionCmdUsage = "Usage:\n" +
+	"  " + programName + " " + debugSessionCmdLiteral + " " + startDebugSessionCmdLiteral +
+	" --logger [logger]=[level]... --trace [type]/[name]... --duration [duration]\n\n"
+
+var startDebugSessionCmdExamples = "Example:\n" +
+	"To debug the Synapse engine and trace an API for 15 minutes\n" +
+	"  " + programName + " " + debugSessionCmdLiteral + " " + startDebugSessionCmdLiteral +
+	" --logger org.apache.synapse=DEBUG --trace api/OrderAPI --duration 15m\n\n" +
+	"To start a session for an hour and return at once\n" +
+	"  " + programName + " " + debugSessionCmdLiteral + " " + startDebugSessionCmdLiteral +
+	" --logger org-apache-coyote=DEBUG --duration 1h --detach\n\n"
+
+var startDebugSessionCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + startDebugSessionCmdLiteral + "\n" +
+	"      --logger\t\tLogger name or class and the level to set, such as org.apache.synapse=DEBUG.\n" +
+	"\t\t\tCan be repeated\n" +
+	"      --trace\t\tArtifact to enable tracing of, as [type]/[name] such as api/OrderAPI. Can be repeated\n" +
+	"      --duration\tDuration of the session (default 15m0s)\n" +
+	"      --detach\t\tReturn at once instead of waiting to revert the changes\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n" +
+	"      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+
+var startDebugSessionCmdHelpString = startDebugSessionCmdLongDesc + startDebugSessionCmdUsage +
+	startDebugSessionCmdExamples + startDebugSessionCmdFlags
+
+var debugSessionStartCmd = &cobra.Command{
+	Use:   startDebugSessionCmdLiteral,
+	Short: startDebugSessionCmdShortDesc,
+	Long:  startDebugSessionCmdLongDesc + startDebugSessionCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleStartDebugSessionCmdArguments(args)
+	},
+}
+
+func init() {
+	debugSessionCmd.AddCommand(debugSessionStartCmd)
+	debugSessionStartCmd.Flags().StringArrayVar(&debugSessionLoggers, "logger", nil,
+		"Logger name or class and the level to set, such as org.apache.synapse=DEBUG")
+	debugSessionStartCmd.Flags().StringArrayVar(&debugSessionTraces, "trace", nil,
+		"Artifact to enable tracing of, as [type]/[name] such as api/OrderAPI")
+	debugSessionStartCmd.Flags().DurationVar(&debugSessionDuration, "duration", 15*time.Minute,
+		"Duration of the session")
+	debugSessionStartCmd.Flags().BoolVar(&debugSessionDetach, "detach", false,
+		"Return at once instead of waiting to revert the changes")
+	debugSessionStartCmd.SetHelpTemplate(startDebugSessionCmdHelpString)
+}
+
+func handleStartDebugSessionCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Start debug session called")
+	if len(args) == 1 && args[0] == utils.HelpCommand {
+		printStartDebugSessionHelp()
+	} else if len(args) > 0 {
+		fmt.Println("Too many arguments. See the usage below")
+		printStartDebugSessionHelp()
+	} else if len(debugSessionLoggers) == 0 && len(debugSessionTraces) == 0 {
+		fmt.Println(programName, debugSessionCmdLiteral, startDebugSessionCmdLiteral, "requires --logger or "+
+			"--trace. See the usage below")
+		printStartDebugSessionHelp()
+	} else if debugSessionDuration <= 0 {
+		fmt.Println("The duration should be positive. See the usage below")
+		printStartDebugSessionHelp()
+	} else {
+		executeStartDebugSessionCmd()
+	}
+}
+
+func printStartDebugSessionHelp() {
+	fmt.Print(startDebugSessionCmdHelpString)
+}
+
+func executeStartDebugSessionCmd() {
+	remoteName := utils.RemoteConfigData.CurrentRemote
+	remote := utils.RemoteConfigData.Remotes[remoteName]
+	filePath := utils.GetDebugSessionFilePath()
+	sessions, err := utils.LoadDebugSessions(filePath)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the debug sessions.", err)
+	}
+	if active, found := sessions.Get(remoteName); found {
+		utils.HandleErrorAndExit("Error starting the debug session.", errors.New("a debug session of remote "+
+			remoteName+" is active until "+active.Expires.Format(debugSessionTimeFormat)+", stop it with '"+
+			programName+" "+debugSessionCmdLiteral+" "+stopDebugSessionCmdLiteral+"' first"))
+	}
+
+	planned := planDebugSession(remote, remoteName)
+	applied, err := utils.ApplyDebugSession(remote, planned, func(applied utils.DebugSession) error {
+		if utils.DryRun {
+			return nil
+		}
+		sessions.Put(applied)
+		return sessions.Save(filePath)
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, utils.LogPrefixError+err.Error())
+		if !applied.IsEmpty() {
+			fmt.Println("Reverting " + countDebugSessionChanges(applied) + " made")
+			revertDebugSession(applied)
+		}
+		os.Exit(1)
+	}
+	if utils.DryRun {
+		fmt.Println("Dry run, the debug session was not started")
+		return
+	}
+
+	printDebugSession(applied)
+	if debugSessionDetach {
+		fmt.Println("The changes are not reverted at " + applied.Expires.Format(debugSessionTimeFormat) +
+			" unless '" + programName + " " + debugSessionCmdLiteral + " " + stopDebugSessionCmdLiteral +
+			"' or '" + programName + " " + debugSessionCmdLiteral + " " + revertExpiredDebugSessionCmdLiteral +
+			"' is run")
+		return
+	}
+	waitForDebugSession(applied)
+}
+
+// record the original levels of the loggers and the tracing of the artifacts of a session
+func planDebugSession(remote utils.Remote, remoteName string) utils.DebugSession {
+	started := time.Now().Truncate(time.Second)
+	session := utils.DebugSession{Remote: remoteName, Started: started, Expires: started.Add(debugSessionDuration)}
+	if len(debugSessionLoggers) > 0 {
+		var loggers utils.LoggerList
+		if err := utils.FetchRemoteData(remote, utils.PrefixLogging, nil, &loggers); err != nil {
+			utils.HandleErrorAndExit("Error getting the log levels.", err)
+		}
+		var err error
+		if session.Loggers, err = utils.PlanDebugSessionLoggers(debugSessionLoggers, loggers.Loggers); err != nil {
+			utils.HandleErrorAndExit("Invalid --logger.", err)
+		}
+	}
+	for _, trace := range debugSessionTraces {
+		artifact, err := utils.ParseTraceableArtifactReference(trace)
+		if err != nil {
+			utils.HandleErrorAndExit("Invalid --trace.", err)
+		}
+		tracing, err := utils.FetchArtifactTracing(remote, artifact)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting the tracing of "+artifact.String()+".", err)
+		}
+		session.Artifacts = append(session.Artifacts, utils.DebugSessionArtifact{Type: artifact.ArtifactType.Name,
+			Name: artifact.Name, OriginalTracing: tracing})
+	}
+	return session
+}
+
+// wait until a debug session expires or the command is interrupted, and revert the session unless it was stopped
+// meanwhile
+func waitForDebugSession(session utils.DebugSession) {
+	fmt.Println("Waiting to revert the changes at " + session.Expires.Format(debugSessionTimeFormat) +
+		". Press Ctrl+C to revert them now")
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	timer := time.NewTimer(time.Until(session.Expires))
+	select {
+	case <-timer.C:
+	case <-signals:
+		fmt.Println()
+	}
+	signal.Stop(signals)
+
+	sessions, err := utils.LoadDebugSessions(utils.GetDebugSessionFilePath())
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the debug sessions.", err)
+	}
+	current, found := sessions.Get(session.Remote)
+	if !found || !current.Started.Equal(session.Started) {
+		fmt.Println("The debug session was already stopped")
+		return
+	}
+	if !revertDebugSession(current) {
+		os.Exit(1)
+	}
+	fmt.Println("Reverted " + countDebugSessionChanges(current) + " of the debug session")
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+var debugSessionRemote string
+
+// Stop debug session command related usage info
+const stopDebugSessionCmdLiteral = "stop"
+const stopDebugSessionCmdShortDesc = "Stop a debug session"
+
+const stopDebugSessionCmdLongDesc = "Revert the log levels and tracing changed by the debug session of the " +
+	"current remote, or of the\nremote given by --remote. Changes which cannot be reverted are kept in the session " +
+	"to retry them\n"
+
+var stopDebugSessionCmdUsage = "Usage:\n" +
+	"  " + programName + " " + debugSessionCmdLiteral + " " + stopDebugSessionCmdLiteral + "\n" +
+	"  " + programName + " " + debugSessionCmdLiteral + " " + stopDebugSessionCmdLiteral + " --remote [remote-name]\n\n"
+
+var stopDebugSessionCmdExamples = "Example:\n" +
+	"  " + programName + " " + debugSessionCmdLiteral + " " + stopDebugSessionCmdLiteral + "\n" +
+	"  " + programName + " " + debugSessionCmdLiteral + " " + stopDebugSessionCmdLiteral + " --remote node1\n\n"
+
+var stopDebugSessionCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + stopDebugSessionCmdLiteral + "\n" +
+	"      --remote\t\tRemote of the session (default is the current remote)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n" +
+	"      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+
+var stopDebugSessionCmdHelpString = stopDebugSessionCmdLongDesc + stopDebugSessionCmdUsage +
+	stopDebugSessionCmdExamples + stopDebugSessionCmdFlags
+
+var debugSessionStopCmd = &cobra.Command{
+	Use:   stopDebugSessionCmdLiteral,
+	Short: stopDebugSessionCmdShortDesc,
+	Long:  stopDebugSessionCmdLongDesc + stopDebugSessionCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleStopDebugSessionCmdArguments(args)
+	},
+}
+
+func init() {
+	debugSessionCmd.AddCommand(debugSessionStopCmd)
+	debugSessionStopCmd.Flags().StringVar(&debugSessionRemote, "remote", "", "Remote of the session")
+	debugSessionStopCmd.SetHelpTemplate(stopDebugSessionCmdHelpString)
+}
+
+func handleStopDebugSessionCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Stop debug session called")
+	if len(args) == 1 && args[0] == utils.HelpCommand {
+		printStopDebugSessionHelp()
+	} else if len(args) > 0 {
+		fmt.Println("Too many arguments. See the usage below")
+		printStopDebugSessionHelp()
+	} else {
+		executeStopDebugSessionCmd()
+	}
+}
+
+func printStopDebugSessionHelp() {
+	fmt.Print(stopDebugSessionCmdHelpString)
+}
+
+func executeStopDebugSessionCmd() {
+	remoteName := debugSessionRemote
+	if remoteName == "" {
+		remoteName = utils.RemoteConfigData.CurrentRemote
+	}
+	sessions, err := utils.LoadDebugSessions(utils.GetDebugSessionFilePath())
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the debug sessions.", err)
+	}
+	session, found := sessions.Get(remoteName)
+	if !found {
+		fmt.Println("No debug session of remote " + remoteName + " is active")
+		return
+	}
+	if !revertDebugSession(session) {
+		os.Exit(1)
+	}
+	if utils.DryRun {
+		fmt.Println("Dry run, the debug session was not stopped")
+		return
+	}
+	fmt.Println("Reverted " + countDebugSessionChanges(session) + " of the debug session of remote " +
+		remoteName)
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+var diffFormat string
+
+// differences of a remote from the baseline remote, printed in the JSON form of the diff
+type remoteDiff struct {
+	Remote  string                  `json:"remote"`
+	Url     string                  `json:"url"`
+	Changes []utils.InventoryChange `json:"changes"`
+}
+
+type remotesDiffResult struct {
+	Baseline  string       `json:"baseline"`
+	Identical bool         `json:"identical"`
+	Remotes   []remoteDiff `json:"remotes"`
+}
+
+// exit status of the diff command when it fails, as status 1 means that the remotes differ
+const diffErrorStatus = 2
+
+// Diff command related usage info
+const diffCmdLiteral = "diff"
+const diffCmdShortDesc = "Compare the deployments of two or more Micro Integrators"
+
+const diffCmdLongDesc = "Fetch the inventories of the given remotes concurrently and report the differences of " +
+	"each remote from\nthe first one, in composite app versions, API versions and contexts, endpoint and message " +
+	"processor states,\nlog levels, connectors and the other artifacts. Values depending on the node such as URLs " +
+	"are ignored.\nExits with status 1 when the remotes differ, and with status 2 when an inventory cannot be " +
+	"fetched or\nanother error occurs\n"
+
+var diffCmdUsage = "Usage:\n" +
+	"  " + programName + " " + diffCmdLiteral + " [remote-name] [remote-name] ...\n\n"
+
+var diffCmdExamples = "Example:\n" +
+	"To compare two nodes of a cluster\n" +
+	"  " + programName + " " + diffCmdLiteral + " node1 node2\n\n" +
+	"To compare three nodes of a cluster in JSON form\n" +
+	"  " + programName + " " + diffCmdLiteral + " node1 node2 node3 --format=json\n\n"
+
+var diffCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + diffCmdLiteral + "\n" +
+	"      --format\t\tOutput format, text or json (default text)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n" +
+	"      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+
+var diffCmdHelpString = diffCmdLongDesc + diffCmdUsage + diffCmdExamples + diffCmdFlags
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   diffCmdLiteral,
+	Short: diffCmdShortDesc,
+	Long:  diffCmdLongDesc + diffCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleDiffCmdArguments(args)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(diffCmd)
+	diffCmd.Flags().StringVar(&diffFormat, "format", utils.OutputFormatText, "Output format, text or json")
+	diffCmd.SetHelpTemplate(diffCmdHelpString)
+}
+
+func handleDiffCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Diff called")
+	if len(args) == 1 && args[0] == utils.HelpCommand {
+		printDiffHelp()
+	} else if len(args) >= 2 {
+		executeDiffCmd(args)
+	} else {
+		fmt.Println(programName, "diff requires at least 2 remotes. See the usage below")
+		printDiffHelp()
+	}
+}
+
+func printDiffHelp() {
+	fmt.Print(diffCmdHelpString)
+}
+
+func executeDiffCmd(remoteNames []string) {
+	if diffFormat != utils.OutputFormatText && diffFormat != utils.OutputFormatJSON {
+		handleDiffErrorAndExit("Invalid format.", errors.New("format must be "+utils.OutputFormatText+
+			" or "+utils.OutputFormatJSON))
+	}
+	for i, remoteName := range remoteNames {
+		if _, exists := utils.RemoteConfigData.Remotes[remoteName]; !exists {
+			handleDiffErrorAndExit("Error: ", errors.New("no such remote: "+remoteName))
+		}
+		if utils.ContainsString(remoteNames[:i], remoteName) {
+			handleDiffErrorAndExit("Error: ", errors.New("remote "+remoteName+" is given more than once"))
+		}
+	}
+
+	inventories, errs := utils.FetchInventories(remoteNames)
+	var failed []string
+	for i, err := range errs {
+		if err != nil {
+			fmt.Fprintln(os.Stderr, utils.LogPrefixError+remoteNames[i]+": "+err.Error())
+			failed = append(failed, remoteNames[i])
+		}
+	}
+	if len(failed) > 0 {
+		handleDiffErrorAndExit("Unable to fetch the inventories of "+strconv.Itoa(len(failed))+" remotes.", nil)
+	}
+
+	result := remotesDiffResult{Baseline: remoteNames[0], Identical: true}
+	for _, inventory := range inventories[1:] {
+		changes := utils.DiffNodeInventories(inventories[0], inventory)
+		if changes == nil {
+			changes = []utils.InventoryChange{}
+		}
+		result.Identical = result.Identical && len(changes) == 0
+		result.Remotes = append(result.Remotes, remoteDiff{Remote: inventory.Remote, Url: inventory.Url,
+			Changes: changes})
+	}
+
+	if diffFormat == utils.OutputFormatJSON {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			handleDiffErrorAndExit("Error formatting the differences.", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		printRemotesDiff(result)
+	}
+	if !result.Identical {
+		os.Exit(1)
+	}
+}
+
+func printRemotesDiff(result remotesDiffResult) {
+	fmt.Println("Comparing with " + result.Baseline + " (+ only on the compared remote, - only on " +
+		result.Baseline + ", ~ changed)")
+	for _, diff := range result.Remotes {
+		fmt.Println()
+		if len(diff.Changes) == 0 {
+			fmt.Println(diff.Remote + " (" + diff.Url + "): no differences")
+			continue
+		}
+		fmt.Println(diff.Remote + " (" + diff.Url + "): " + strconv.Itoa(len(diff.Changes)) + " differences")
+		for _, change := range diff.Changes {
+			fmt.Println("  " + change.String())
+		}
+	}
+	fmt.Println()
+	if result.Identical {
+		fmt.Println("The remotes are identical")
+	} else {
+		fmt.Println("The remotes differ")
+	}
+}
+
+// exit with the status telling errors from differences
+func handleDiffErrorAndExit(msg string, err error) {
+	utils.HandleErrorAndExitWithStatus(msg, err, diffErrorStatus)
+}
@@ -52,8 +52,9 @@ var endpointShowCmd = &cobra.Command{
 
 func init() {
 	endpointCmd.AddCommand(endpointShowCmd)
+	addListFlags(endpointShowCmd)
 	endpointShowCmd.SetHelpTemplate(showEndpointCmdLongDesc + utils.GetCmdUsage(programName, endpointCmdLiteral,
-		showEndpointCmdLiteral, "[endpoint-name]") + showEndpointCmdExamples + utils.GetCmdFlags(endpointCmdLiteral))
+		showEndpointCmdLiteral, "[endpoint-name]") + showEndpointCmdExamples + utils.GetListCmdFlags(endpointCmdLiteral, &artifactUtils.EndpointList{}))
 }
 
 func handleEndpointCmdArguments(args []string) {
@@ -75,7 +76,7 @@ func handleEndpointCmdArguments(args []string) {
 
 func printEndpointHelp() {
 	fmt.Print(showEndpointCmdLongDesc + utils.GetCmdUsage(programName, endpointCmdLiteral, showEndpointCmdLiteral,
-		"[endpoint-name]") + showEndpointCmdExamples + utils.GetCmdFlags(endpointCmdLiteral))
+		"[endpoint-name]") + showEndpointCmdExamples + utils.GetListCmdFlags(endpointCmdLiteral, &artifactUtils.EndpointList{}))
 }
 
 func executeGetEndpointCmd(endpointname string) {
@@ -124,6 +125,12 @@ func printEndpoint(endpoint artifactUtils.Endpoint) {
 	if len(endpoint.WsdlURI) > 0 {
 		fmt.Println("WSDL URI - " + endpoint.WsdlURI)
 	}
+	if len(endpoint.Stats) > 0 {
+		fmt.Println("Stats - " + endpoint.Stats)
+	}
+	if len(endpoint.Tracing) > 0 {
+		fmt.Println("Tracing - " + endpoint.Tracing)
+	}
 
 }
 
@@ -136,7 +143,7 @@ func executeListEndpointsCmd() {
 	if err == nil {
 		// Printing the list of available Endpoints
 		list := resp.(*artifactUtils.EndpointList)
-		utils.PrintItemList(list, []string{utils.Name, utils.Type, utils.IsActive}, "No endpoints found")
+		utils.PrintItemList(list, "No endpoints found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Endpoints", err)
 	}
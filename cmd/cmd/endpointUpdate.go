@@ -21,22 +21,35 @@ package cmd
 import (
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
 	"fmt"
 )
 
 const updateEndpointCmdLiteral = "update"
 const endpointState = "state"
-const updateEndpointCmdShortDesc = "Update state of a endpoint"
+const updateEndpointCmdShortDesc = "Update state, statistics or tracing of a endpoint"
 
-const updateEndpointCmdLongDesc = "Activate and Deactivate a specified endpoint \n"
+const updateEndpointCmdLongDesc = "Activate and Deactivate a specified endpoint, or enable and disable its statistics and tracing\n" +
+	"Several endpoints can be named, or selected with --filter or --from-file, to update them concurrently\n" +
+	"Deactivating asks for confirmation unless --yes is given\n"
 
 var updateEndpointCmdUsage = "Usage:\n" +
-	"  " + programName + " " + endpointCmdLiteral + " " + updateEndpointCmdLiteral + " [endpoint-name] " + endpointState + " [state]\n\n"
+	"  " + programName + " " + endpointCmdLiteral + " " + updateEndpointCmdLiteral + " [endpoint-name]... " + endpointState + " [state]\n" +
+	"  " + programName + " " + endpointCmdLiteral + " " + updateEndpointCmdLiteral + " [endpoint-name]... [" + utils.ArtifactPropertyTracing + "|" + utils.ArtifactPropertyStatistics + "] [enable|disable]\n" +
+	"  " + programName + " " + endpointCmdLiteral + " " + updateEndpointCmdLiteral + " " + endpointState + " [state] --filter [expression]\n" +
+	"  " + programName + " " + endpointCmdLiteral + " " + updateEndpointCmdLiteral + " " + endpointState + " [state] --from-file [file-path]\n\n"
 
 var updateEndpointCmdExamples = "Example:\n" +
-	"  " + programName + " " + endpointCmdLiteral + " " + updateEndpointCmdLiteral + " testEndpoint state inactive\n\n"
+	"  " + programName + " " + endpointCmdLiteral + " " + updateEndpointCmdLiteral + " testEndpoint state inactive\n" +
+	"  " + programName + " " + endpointCmdLiteral + " " + updateEndpointCmdLiteral + " testEndpoint state inactive --yes\n" +
+	"To deactivate all the endpoints whose names start with order\n" +
+	"  " + programName + " " + endpointCmdLiteral + " " + updateEndpointCmdLiteral + " state inactive --filter 'name~^order'\n" +
+	"To activate the endpoints listed in a file\n" +
+	"  " + programName + " " + endpointCmdLiteral + " " + updateEndpointCmdLiteral + " state active --from-file endpoints.txt\n" +
+	"To enable tracing of an endpoint\n" +
+	"  " + programName + " " + endpointCmdLiteral + " " + updateEndpointCmdLiteral + " testEndpoint tracing enable\n\n"
 
-var updateEndpointCmdHelpString = updateEndpointCmdShortDesc + updateEndpointCmdUsage + updateEndpointCmdExamples
+var updateEndpointCmdHelpString = updateEndpointCmdLongDesc + updateEndpointCmdUsage + updateEndpointCmdExamples
 
 var endpointUpdateCmd = &cobra.Command{
 	Use:   updateEndpointCmdLiteral,
@@ -49,26 +62,29 @@ var endpointUpdateCmd = &cobra.Command{
 
 func init() {
 	endpointCmd.AddCommand(endpointUpdateCmd)
-	endpointUpdateCmd.SetHelpTemplate(updateEndpointCmdHelpString + utils.GetCmdFlags(updateEndpointCmdLiteral))
+	endpointUpdateCmd.SetHelpTemplate(updateEndpointCmdHelpString + utils.GetStateUpdateCmdFlags(updateEndpointCmdLiteral, &artifactUtils.EndpointList{}))
+	addStateUpdateFlags(endpointUpdateCmd)
 }
 
 func handleUpdateEndpointCmdArguments(args []string) {
-	if len(args) == 3 {
-		if args[0] == "help" || args[1] == "help" || args[2] == "help" {
-			printUpdateEndpointHelp()
-		} else if args[1] != endpointState {
-			printInvalidEndpointUpdateCmdMessage(args)
-		} else {
-			updateEndpointState(args[0], args[2])
-		}
-	} else {
+	if utils.ContainsString(args, "help") {
+		printUpdateEndpointHelp()
+	} else if names, property, state, valid := parseArtifactUpdateArgs(args, endpointState,
+		utils.ArtifactPropertyTracing, utils.ArtifactPropertyStatistics); !valid {
 		printInvalidEndpointUpdateCmdMessage(args)
+	} else if property != endpointState {
+		executePropertyUpdate(endpointCmdLiteral, "endpoint", names, property, state)
+	} else if isSingleStateUpdate(names) {
+		updateEndpointState(names[0], state)
+	} else {
+		executeBulkStateUpdate(endpointCmdLiteral, "endpoint", names, state)
 	}
 }
 
 func printInvalidEndpointUpdateCmdMessage(args []string) {
 	fmt.Println("endpoint update:", args, "is not a valid command.\n"+
-		programName, "endpoint update requires 3 arguments. See the usage below.")
+		programName, "endpoint update requires endpoint names, --filter or --from-file followed by state [state], " +
+		"tracing [enable|disable] or statistics [enable|disable]. See the usage below.")
 	printUpdateEndpointHelp()
 }
 
@@ -77,6 +93,9 @@ func printUpdateEndpointHelp() {
 }
 
 func updateEndpointState(endpoint string, intendedState string)  {
+	if utils.IsStateReducing(intendedState) {
+		utils.ConfirmCurrentRemoteOperation("deactivate endpoint "+endpoint, confirmYes)
+	}
 	resp, err := utils.UpdateMIEndpoint(endpoint, intendedState)
 
 	if err != nil {
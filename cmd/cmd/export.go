@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+var exportTypes []string
+var exportRemote string
+
+// Export command related usage info
+const exportCmdLiteral = "export"
+const exportCmdShortDesc = "Export the Synapse configurations deployed in a Micro Integrator to a directory"
+
+var exportCmdLongDesc = "Fetch the configuration of every artifact deployed in the Micro Integrator concurrently " +
+	"and write each one\nto its own file under [dir-path], in a directory per artifact type following the " +
+	"Synapse layout such as\napis/ and sequences/, together with an " + utils.ExportIndexFileName +
+	" manifest listing the artifacts and their checksums.\n[dir-path] must not exist or be empty. " +
+	"Exits with status 1 when some configurations cannot be exported\n"
+
+var exportCmdUsage = "Usage:\n" +
+	"  " + programName + " " + exportCmdLiteral + " [dir-path]\n" +
+	"  " + programName + " " + exportCmdLiteral + " [dir-path] --types=[type],[type] --remote=[remote-name]\n\n"
+
+var exportCmdExamples = "Example:\n" +
+	"To back up the configurations deployed in the current remote\n" +
+	"  " + programName + " " + exportCmdLiteral + " backup-2020-06-01\n\n" +
+	"To export only the APIs and endpoints of a remote\n" +
+	"  " + programName + " " + exportCmdLiteral + " audit --types=api,endpoint --remote=node1\n\n"
+
+var exportCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + exportCmdLiteral + "\n" +
+	"      --types\t\tComma separated artifact types to export (default is all the types with configurations)\n" +
+	"      --remote\t\tRemote to export from (default is the current remote)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n" +
+	"      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+
+var exportCmdHelpString = exportCmdLongDesc + exportCmdUsage + exportCmdExamples + exportCmdFlags
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   exportCmdLiteral,
+	Short: exportCmdShortDesc,
+	Long:  exportCmdLongDesc + exportCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleExportCmdArguments(args)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringSliceVar(&exportTypes, "types", nil, "Comma separated artifact types to export")
+	exportCmd.Flags().StringVar(&exportRemote, "remote", "", "Remote to export from")
+	exportCmd.SetHelpTemplate(exportCmdHelpString)
+}
+
+func handleExportCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Export called")
+	if len(args) == 0 {
+		fmt.Println("Please provide the path of the directory to export to. See the usage below")
+		printExportHelp()
+	} else if len(args) == 1 {
+		if args[0] == utils.HelpCommand {
+			printExportHelp()
+		} else {
+			executeExportCmd(args[0])
+		}
+	} else {
+		fmt.Println("Too many arguments. See the usage below")
+		printExportHelp()
+	}
+}
+
+func printExportHelp() {
+	fmt.Print(exportCmdHelpString)
+}
+
+func executeExportCmd(dir string) {
+	var artifactTypes []utils.ArtifactType
+	for _, typeName := range exportTypes {
+		artifactType, err := utils.GetArtifactType(strings.TrimSpace(typeName))
+		if err != nil {
+			utils.HandleErrorAndExit("Invalid artifact type.", err)
+		}
+		if artifactType.ConfigParams == nil {
+			utils.HandleErrorAndExit("Invalid artifact type.", errors.New("the configurations of "+
+				artifactType.Name+" artifacts are not available from the server. Supported types: "+
+				strings.Join(getExportableTypeNames(), ", ")))
+		}
+		artifactTypes = append(artifactTypes, artifactType)
+	}
+	remoteName := exportRemote
+	if remoteName == "" {
+		remoteName = utils.RemoteConfigData.CurrentRemote
+	}
+	remote, exists := utils.RemoteConfigData.Remotes[remoteName]
+	if !exists {
+		utils.HandleErrorAndExit("Error: ", errors.New("no such remote: "+remoteName))
+	}
+
+	index, err := utils.ExportArtifactConfigurations(remoteName, remote, artifactTypes, dir)
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting the configurations of "+remoteName+".", err)
+	}
+	for _, failure := range index.Failures {
+		fmt.Fprintln(os.Stderr, utils.LogPrefixError+failure.Error)
+	}
+	fmt.Println("Exported " + strconv.Itoa(len(index.Artifacts)) + " configurations of " + remoteName + " to " +
+		dir)
+	if len(index.Failures) > 0 {
+		fmt.Println(strconv.Itoa(len(index.Failures)) + " configurations could not be exported, see " +
+			utils.ExportIndexFileName)
+		os.Exit(1)
+	}
+}
+
+func getExportableTypeNames() []string {
+	var names []string
+	for _, artifactType := range utils.ArtifactTypes {
+		if artifactType.ConfigParams != nil {
+			names = append(names, artifactType.Name)
+		}
+	}
+	return names
+}
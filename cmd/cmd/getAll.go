@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+// Get all command related usage info
+const getAllCmdLiteral = "all"
+const getAllCmdShortDesc = "Get an inventory summary of the Micro Integrator"
+
+const getAllCmdLongDesc = "Fetch the lists of all the artifact types concurrently and print the number of " +
+	"artifacts of each type,\nalong with the artifacts needing attention such as faulty composite apps, " +
+	"inactive endpoints,\ndeactivated message processors and message stores which are not empty\n"
+
+var getAllCmdExamples = "Example:\n" +
+	"To get the inventory summary of the current Micro Integrator\n" +
+	"  " + programName + " " + getCmdLiteral + " " + getAllCmdLiteral + "\n\n"
+
+// getAllCmd represents the get all command
+var getAllCmd = &cobra.Command{
+	Use:   getAllCmdLiteral,
+	Short: getAllCmdShortDesc,
+	Long:  getAllCmdLongDesc + getAllCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleGetAllCmdArguments(args)
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getAllCmd)
+	getAllCmd.SetHelpTemplate(getAllCmdLongDesc + utils.GetCmdUsageForNonArguments(programName, getCmdLiteral,
+		getAllCmdLiteral) + getAllCmdExamples + utils.GetCmdFlags(getCmdLiteral))
+}
+
+func handleGetAllCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Get all called")
+	if len(args) == 0 {
+		executeGetAllCmd()
+	} else if len(args) == 1 && args[0] == utils.HelpCommand {
+		printGetAllHelp()
+	} else {
+		fmt.Println("Too many arguments. See the usage below")
+		printGetAllHelp()
+	}
+}
+
+func printGetAllHelp() {
+	fmt.Print(getAllCmdLongDesc + utils.GetCmdUsageForNonArguments(programName, getCmdLiteral, getAllCmdLiteral) +
+		getAllCmdExamples + utils.GetCmdFlags(getCmdLiteral))
+}
+
+func executeGetAllCmd() {
+	remoteName := utils.RemoteConfigData.CurrentRemote
+	remote := utils.RemoteConfigData.Remotes[remoteName]
+	results := utils.FetchArtifactLists(remote, utils.ArtifactTypes)
+
+	fmt.Println("Remote - " + remoteName + " (" + remote.Url + ":" + remote.Port + ")")
+	fmt.Println("Artifacts :")
+	table := utils.GetTableWriter()
+	table.Append([]string{artifactUtils.ColumnType, artifactUtils.ColumnCount})
+	var failed []utils.ArtifactListResult
+	for _, result := range results {
+		count := "-"
+		if result.Err != nil {
+			failed = append(failed, result)
+		} else {
+			count = strconv.Itoa(len(result.List.GetRows()))
+		}
+		table.Append([]string{result.ArtifactType.Name, count})
+	}
+	table.Render()
+
+	printInventoryProblems(results)
+
+	if len(failed) > 0 {
+		fmt.Println("Errors :")
+		for _, result := range failed {
+			fmt.Println("  " + result.ArtifactType.Name + ": " + result.Err.Error())
+		}
+		utils.HandleErrorAndExit("Unable to fetch "+strconv.Itoa(len(failed))+" of the artifact lists.",
+			failed[0].Err)
+	}
+}
+
+// Print the artifacts satisfying the problem condition of their type
+// @param results : fetched artifact lists
+func printInventoryProblems(results []utils.ArtifactListResult) {
+	table := utils.GetTableWriter()
+	table.Append([]string{"ARTIFACT", "PROBLEM"})
+	problemCount := 0
+	for _, result := range results {
+		if result.Err != nil {
+			continue
+		}
+		for _, row := range utils.GetProblemRows(result.ArtifactType, result.List) {
+			table.Append([]string{result.ArtifactType.Name + "/" + row.Cells[0], result.ArtifactType.Problem(row)})
+			problemCount++
+		}
+	}
+	if problemCount == 0 {
+		fmt.Println("No problems found")
+		return
+	}
+	fmt.Println("Problems :")
+	table.Render()
+}
@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+var graphFormat string
+var graphImpact string
+var graphRemote string
+
+// artifacts depending on an artifact, printed in the JSON form of an impact query
+type graphImpactResult struct {
+	Artifact   utils.GraphNode   `json:"artifact"`
+	Dependents []utils.GraphNode `json:"dependents"`
+}
+
+// Graph command related usage info
+const graphCmdLiteral = "graph"
+const graphCmdShortDesc = "Export the dependency graph of the artifacts of a deployment"
+
+const graphCmdLongDesc = "Build the graph of the artifacts using each other, such as APIs and proxy services " +
+	"using sequences which use\nendpoints and templates, message processors using message stores and inbound " +
+	"endpoints using sequences.\nThe artifacts are read from a Synapse artifact directory, a Composite App archive " +
+	"or, without a path,\nthe artifacts deployed in a remote. The graph is exported in Graphviz DOT, Mermaid or " +
+	"JSON form.\nWith --impact, lists the artifacts depending on the given artifact directly or indirectly\n"
+
+var graphCmdUsage = "Usage:\n" +
+	"  " + programName + " " + graphCmdLiteral + " [dir-path|file-path] --format=[dot|mermaid|json]\n" +
+	"  " + programName + " " + graphCmdLiteral + " --remote=[remote-name] --format=[dot|mermaid|json]\n" +
+	"  " + programName + " " + graphCmdLiteral + " [dir-path|file-path] --impact=[artifact]\n\n"
+
+var graphCmdExamples = "Example:\n" +
+	"To render the dependency graph of the artifacts deployed in the current remote\n" +
+	"  " + programName + " " + graphCmdLiteral + " | dot -Tsvg > dependencies.svg\n\n" +
+	"To export the dependency graph of a Composite App archive as a Mermaid flowchart\n" +
+	"  " + programName + " " + graphCmdLiteral + " OrderCApp_1.0.0.car --format=mermaid\n\n" +
+	"To list the artifacts which break if an endpoint goes down\n" +
+	"  " + programName + " " + graphCmdLiteral + " --remote=node1 --impact=StockEP\n\n" +
+	"To resolve an artifact name used by several kinds of artifacts\n" +
+	"  " + programName + " " + graphCmdLiteral + " order-integration --impact=endpoint/Order\n\n"
+
+var graphCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + graphCmdLiteral + "\n" +
+	"      --format\t\tOutput format, dot, mermaid or json, or text or json with --impact\n" +
+	"\t\t\t(default dot, or text with --impact)\n" +
+	"      --impact\t\tList the artifacts depending on the given artifact, by name or kind/name\n" +
+	"      --remote\t\tRemote to read the deployed artifacts from (default is the current remote)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n"
+
+var graphCmdHelpString = graphCmdLongDesc + graphCmdUsage + graphCmdExamples + graphCmdFlags
+
+// graphCmd represents the graph command
+var graphCmd = &cobra.Command{
+	Use:   graphCmdLiteral,
+	Short: graphCmdShortDesc,
+	Long:  graphCmdLongDesc + graphCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleGraphCmdArguments(args)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(graphCmd)
+	graphCmd.Flags().StringVar(&graphFormat, "format", "", "Output format, dot, mermaid or json")
+	graphCmd.Flags().StringVar(&graphImpact, "impact", "", "List the artifacts depending on the given artifact")
+	graphCmd.Flags().StringVar(&graphRemote, "remote", "", "Remote to read the deployed artifacts from")
+	graphCmd.SetHelpTemplate(graphCmdHelpString)
+}
+
+func handleGraphCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Graph called")
+	if len(args) == 0 {
+		executeGraphCmd("")
+	} else if len(args) == 1 {
+		if args[0] == utils.HelpCommand {
+			printGraphHelp()
+		} else {
+			executeGraphCmd(args[0])
+		}
+	} else {
+		fmt.Println("Too many arguments. See the usage below")
+		printGraphHelp()
+	}
+}
+
+func printGraphHelp() {
+	fmt.Print(graphCmdHelpString)
+}
+
+func executeGraphCmd(path string) {
+	format := graphFormat
+	if graphImpact == "" {
+		if format == "" {
+			format = utils.OutputFormatDOT
+		}
+		if format != utils.OutputFormatDOT && format != utils.OutputFormatMermaid &&
+			format != utils.OutputFormatJSON {
+			utils.HandleErrorAndExit("Invalid format.", errors.New("format must be "+utils.OutputFormatDOT+", "+
+				utils.OutputFormatMermaid+" or "+utils.OutputFormatJSON))
+		}
+	} else {
+		if format == "" {
+			format = utils.OutputFormatText
+		}
+		if format != utils.OutputFormatText && format != utils.OutputFormatJSON {
+			utils.HandleErrorAndExit("Invalid format.", errors.New("format must be "+utils.OutputFormatText+
+				" or "+utils.OutputFormatJSON+" with --impact"))
+		}
+	}
+	if path != "" && graphRemote != "" {
+		utils.HandleErrorAndExit("Error: ", errors.New("give either a path or a remote, not both"))
+	}
+
+	configs, fileErrors := loadGraphConfigs(path)
+	for _, fileError := range fileErrors {
+		fmt.Fprintln(os.Stderr, utils.LogPrefixWarning+"Skipping "+fileError.File+": "+fileError.Message)
+	}
+	graph := utils.BuildDependencyGraph(configs)
+
+	if graphImpact != "" {
+		printGraphImpact(graph, format)
+		return
+	}
+	switch format {
+	case utils.OutputFormatMermaid:
+		fmt.Print(graph.Mermaid())
+	case utils.OutputFormatJSON:
+		data, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			utils.HandleErrorAndExit("Error formatting the dependency graph.", err)
+		}
+		fmt.Println(string(data))
+	default:
+		fmt.Print(graph.DOT())
+	}
+}
+
+// read the configurations from the given path or, without a path, from the selected remote
+func loadGraphConfigs(path string) ([]utils.SynapseConfig, []utils.SynapseFileError) {
+	if path != "" {
+		configs, fileErrors, err := utils.LoadSynapseConfigs(path)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading the Synapse configurations.", err)
+		}
+		return configs, fileErrors
+	}
+	remoteName := graphRemote
+	if remoteName == "" {
+		remoteName = utils.RemoteConfigData.CurrentRemote
+	}
+	remote, exists := utils.RemoteConfigData.Remotes[remoteName]
+	if !exists {
+		utils.HandleErrorAndExit("Error: ", errors.New("no such remote: "+remoteName))
+	}
+	configs, fileErrors, err := utils.FetchSynapseConfigs(remote)
+	if err != nil {
+		utils.HandleErrorAndExit("Error fetching the Synapse configurations from "+remoteName+".", err)
+	}
+	return configs, fileErrors
+}
+
+func printGraphImpact(graph utils.DependencyGraph, format string) {
+	artifact, err := graph.FindNode(graphImpact)
+	if err != nil {
+		utils.HandleErrorAndExit("Error: ", err)
+	}
+	dependents := graph.Dependents(artifact.Id)
+	if format == utils.OutputFormatJSON {
+		data, err := json.MarshalIndent(graphImpactResult{Artifact: artifact, Dependents: dependents}, "", "  ")
+		if err != nil {
+			utils.HandleErrorAndExit("Error formatting the dependents.", err)
+		}
+		fmt.Println(string(data))
+		return
+	}
+	if len(dependents) == 0 {
+		fmt.Println("No artifacts depend on " + artifact.Id)
+		return
+	}
+	fmt.Println("Artifacts depending on " + artifact.Id + " :")
+	for _, node := range dependents {
+		fmt.Println("  " + node.Id)
+	}
+}
@@ -53,8 +53,9 @@ var inboundEndpointShowCmd = &cobra.Command{
 
 func init() {
 	inboundEndpointCmd.AddCommand(inboundEndpointShowCmd)
+	addListFlags(inboundEndpointShowCmd)
 	inboundEndpointShowCmd.SetHelpTemplate(showInboundEndpointCmdLongDesc + utils.GetCmdUsage(programName, inboundEndpointCmdLiteral,
-		showInboundEndpointCmdLiteral, "[inbound-name]") + showInboundEndpointCmdExamples + utils.GetCmdFlags(inboundEndpointCmdLiteral))
+		showInboundEndpointCmdLiteral, "[inbound-name]") + showInboundEndpointCmdExamples + utils.GetListCmdFlags(inboundEndpointCmdLiteral, &artifactUtils.InboundEndpointList{}))
 }
 
 func handleInboundCmdArguments(args []string) {
@@ -76,7 +77,7 @@ func handleInboundCmdArguments(args []string) {
 
 func printInboundHelp() {
 	fmt.Print(showInboundEndpointCmdLongDesc + utils.GetCmdUsage(programName, inboundEndpointCmdLiteral, showInboundEndpointCmdLiteral,
-		"[inbound-name]") + showInboundEndpointCmdExamples + utils.GetCmdFlags(inboundEndpointCmdLiteral))
+		"[inbound-name]") + showInboundEndpointCmdExamples + utils.GetListCmdFlags(inboundEndpointCmdLiteral, &artifactUtils.InboundEndpointList{}))
 }
 
 func executeGetInboundEndpointCmd(inboundEndpointname string) {
@@ -103,6 +104,9 @@ func printInboundEndpoint(inbound artifactUtils.InboundEndpoint) {
 	fmt.Println("Type - " + inbound.Type)
 	fmt.Println("Stats - " + inbound.Stats)
 	fmt.Println("Tracing - " + inbound.Tracing)
+	if inbound.IsActive != nil {
+		fmt.Println("State - " + getInboundEndpointState(*inbound.IsActive))
+	}
 	fmt.Println("Parameters : ")
 
 	table := tablewriter.NewWriter(os.Stdout)
@@ -129,7 +133,7 @@ func executeListInboundEndpointsCmd() {
 	if err == nil {
 		// Printing the list of available Inbound endpoints
 		list := resp.(*artifactUtils.InboundEndpointList)
-		utils.PrintItemList(list, []string{utils.Name, utils.Type}, "No inbound endpoints found")
+		utils.PrintItemList(list, "No inbound endpoints found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Inbound Endpoints", err)
 	}
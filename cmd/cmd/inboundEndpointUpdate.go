@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+const updateInboundEndpointCmdLiteral = "update"
+const inboundEndpointState = "state"
+const updateInboundEndpointCmdShortDesc = "Update state, statistics or tracing of an inbound endpoint"
+
+const updateInboundEndpointCmdLongDesc = "Activate and Deactivate a specified inbound endpoint, such as a JMS or " +
+	"file polling listener,\nand print its new state, or enable and disable its statistics and tracing\n" +
+	"Several inbound endpoints can be named, or selected with --filter or --from-file, to update them concurrently\n" +
+	"Deactivating asks for confirmation unless --yes is given\n"
+
+var updateInboundEndpointCmdUsage = "Usage:\n" +
+	"  " + programName + " " + inboundEndpointCmdLiteral + " " + updateInboundEndpointCmdLiteral + " [inbound-name]... " + inboundEndpointState + " [state]\n" +
+	"  " + programName + " " + inboundEndpointCmdLiteral + " " + updateInboundEndpointCmdLiteral + " [inbound-name]... [" + utils.ArtifactPropertyTracing + "|" + utils.ArtifactPropertyStatistics + "] [enable|disable]\n" +
+	"  " + programName + " " + inboundEndpointCmdLiteral + " " + updateInboundEndpointCmdLiteral + " " + inboundEndpointState + " [state] --filter [expression]\n" +
+	"  " + programName + " " + inboundEndpointCmdLiteral + " " + updateInboundEndpointCmdLiteral + " " + inboundEndpointState + " [state] --from-file [file-path]\n\n"
+
+var updateInboundEndpointCmdExamples = "Example:\n" +
+	"  " + programName + " " + inboundEndpointCmdLiteral + " " + updateInboundEndpointCmdLiteral + " TestInboundEndpoint state inactive\n" +
+	"  " + programName + " " + inboundEndpointCmdLiteral + " " + updateInboundEndpointCmdLiteral + " TestInboundEndpoint state active\n" +
+	"To deactivate all the JMS listeners\n" +
+	"  " + programName + " " + inboundEndpointCmdLiteral + " " + updateInboundEndpointCmdLiteral + " state inactive --filter type=jms\n" +
+	"To enable tracing of an inbound endpoint\n" +
+	"  " + programName + " " + inboundEndpointCmdLiteral + " " + updateInboundEndpointCmdLiteral + " TestInboundEndpoint tracing enable\n\n"
+
+var updateInboundEndpointCmdHelpString = updateInboundEndpointCmdLongDesc + updateInboundEndpointCmdUsage +
+	updateInboundEndpointCmdExamples
+
+var inboundEndpointUpdateCmd = &cobra.Command{
+	Use:   updateInboundEndpointCmdLiteral,
+	Short: updateInboundEndpointCmdShortDesc,
+	Long:  updateInboundEndpointCmdLongDesc + updateInboundEndpointCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleUpdateInboundEndpointCmdArguments(args)
+	},
+}
+
+func init() {
+	inboundEndpointCmd.AddCommand(inboundEndpointUpdateCmd)
+	inboundEndpointUpdateCmd.SetHelpTemplate(updateInboundEndpointCmdHelpString +
+		utils.GetStateUpdateCmdFlags(updateInboundEndpointCmdLiteral, &artifactUtils.InboundEndpointList{}))
+	addStateUpdateFlags(inboundEndpointUpdateCmd)
+}
+
+func handleUpdateInboundEndpointCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Update inbound endpoint called")
+	if utils.ContainsString(args, "help") {
+		printUpdateInboundEndpointHelp()
+	} else if names, property, state, valid := parseArtifactUpdateArgs(args, inboundEndpointState,
+		utils.ArtifactPropertyTracing, utils.ArtifactPropertyStatistics); !valid {
+		printInvalidInboundEndpointUpdateCmdMessage(args)
+	} else if property != inboundEndpointState {
+		executePropertyUpdate(inboundEndpointCmdLiteral, "inbound endpoint", names, property, state)
+	} else if isSingleStateUpdate(names) {
+		updateInboundEndpointState(names[0], state)
+	} else {
+		executeBulkStateUpdate(inboundEndpointCmdLiteral, "inbound endpoint", names, state)
+	}
+}
+
+func printInvalidInboundEndpointUpdateCmdMessage(args []string) {
+	fmt.Println("inboundendpoint update:", args, "is not a valid command.\n"+
+		programName, "inboundendpoint update requires inbound endpoint names, --filter or --from-file followed by "+
+		"state [state], tracing [enable|disable] or statistics [enable|disable]. See the usage below.")
+	printUpdateInboundEndpointHelp()
+}
+
+func printUpdateInboundEndpointHelp() {
+	fmt.Println(updateInboundEndpointCmdHelpString)
+}
+
+func updateInboundEndpointState(inboundEndpoint string, intendedState string) {
+	if utils.IsStateReducing(intendedState) {
+		utils.ConfirmCurrentRemoteOperation("deactivate inbound endpoint "+inboundEndpoint, confirmYes)
+	}
+	resp, err := utils.UpdateMIInboundEndpoint(inboundEndpoint, intendedState)
+	if err != nil {
+		fmt.Println(utils.LogPrefixError+"Updating state of inbound endpoint failed: ", err)
+		os.Exit(1)
+	}
+	fmt.Println(resp)
+	if !utils.DryRun {
+		printInboundEndpointState(inboundEndpoint)
+	}
+}
+
+// Print the state of an inbound endpoint as reported by the current remote
+// @param inboundEndpoint : name of the inbound endpoint
+func printInboundEndpointState(inboundEndpoint string) {
+	remote := utils.RemoteConfigData.Remotes[utils.RemoteConfigData.CurrentRemote]
+	var detail artifactUtils.InboundEndpoint
+	err := utils.FetchRemoteData(remote, utils.PrefixInboundEndpoints,
+		map[string]string{"inboundEndpointName": inboundEndpoint}, &detail)
+	if err != nil {
+		fmt.Println(utils.LogPrefixError+"Getting the state of inbound endpoint "+inboundEndpoint+" failed: ", err)
+	} else if detail.IsActive == nil {
+		fmt.Println("The server does not report the state of inbound endpoint " + inboundEndpoint)
+	} else {
+		fmt.Println("Inbound endpoint " + inboundEndpoint + " is " + getInboundEndpointState(*detail.IsActive))
+	}
+}
+
+// describe the state of an inbound endpoint as used in the state argument
+func getInboundEndpointState(isActive bool) string {
+	if isActive {
+		return utils.DesiredStateActive
+	}
+	return utils.DesiredStateInactive
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+var lintFormat string
+
+// Lint command related usage info
+const lintCmdLiteral = "lint"
+const lintCmdShortDesc = "Check Synapse configurations for broken references and conflicts"
+
+const lintCmdLongDesc = "Parse the Synapse configurations of a directory with the conventional layout or of a " +
+	"Composite App archive\nand report the problems which would make the deployment fail, such as sequences, " +
+	"endpoints and templates\nused but not defined, duplicate artifact names, APIs with overlapping contexts and " +
+	"versions, proxy services\nwithout a target, message processors using undefined message stores and tasks " +
+	"missing properties.\nSequences, endpoints, local entries and templates share the names of a single " +
+	"registry, so the same\nname cannot be used by two of them.\nExits with status 1 when problems are found\n"
+
+var lintCmdUsage = "Usage:\n" +
+	"  " + programName + " " + lintCmdLiteral + " [dir-path]\n" +
+	"  " + programName + " " + lintCmdLiteral + " [file-path]\n\n"
+
+var lintCmdExamples = "Example:\n" +
+	"To check the Synapse configurations of a directory\n" +
+	"  " + programName + " " + lintCmdLiteral + " order-integration\n\n" +
+	"To check a Composite App archive and write the problems in SARIF form\n" +
+	"  " + programName + " " + lintCmdLiteral + " OrderCApp_1.0.0.car --format=sarif > lint.sarif\n\n"
+
+var lintCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + lintCmdLiteral + "\n" +
+	"      --format\t\tOutput format, text, json or sarif (default text)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n"
+
+var lintCmdHelpString = lintCmdLongDesc + lintCmdUsage + lintCmdExamples + lintCmdFlags
+
+// lintCmd represents the lint command
+var lintCmd = &cobra.Command{
+	Use:   lintCmdLiteral,
+	Short: lintCmdShortDesc,
+	Long:  lintCmdLongDesc + lintCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleLintCmdArguments(args)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(lintCmd)
+	lintCmd.Flags().StringVar(&lintFormat, "format", utils.OutputFormatText, "Output format, text, json or sarif")
+	lintCmd.SetHelpTemplate(lintCmdHelpString)
+}
+
+func handleLintCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Lint called")
+	if len(args) == 0 {
+		fmt.Println("Please provide the path of a Synapse artifact directory or a Composite App archive. " +
+			"See the usage below")
+		printLintHelp()
+	} else if len(args) == 1 {
+		if args[0] == utils.HelpCommand {
+			printLintHelp()
+		} else {
+			executeLintCmd(args[0])
+		}
+	} else {
+		fmt.Println("Too many arguments. See the usage below")
+		printLintHelp()
+	}
+}
+
+func printLintHelp() {
+	fmt.Print(lintCmdHelpString)
+}
+
+func executeLintCmd(path string) {
+	if lintFormat != utils.OutputFormatText && lintFormat != utils.OutputFormatJSON &&
+		lintFormat != utils.OutputFormatSARIF {
+		utils.HandleErrorAndExit("Invalid format.", errors.New("format must be "+utils.OutputFormatText+", "+
+			utils.OutputFormatJSON+" or "+utils.OutputFormatSARIF))
+	}
+	configs, fileErrors, err := utils.LoadSynapseConfigs(path)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the Synapse configurations.", err)
+	}
+	diagnostics := utils.LintSynapseConfigs(configs, fileErrors)
+
+	switch lintFormat {
+	case utils.OutputFormatJSON:
+		if diagnostics == nil {
+			diagnostics = []utils.LintDiagnostic{}
+		}
+		printLintJSON(diagnostics)
+	case utils.OutputFormatSARIF:
+		printLintJSON(utils.NewLintSarifLog(diagnostics))
+	default:
+		for _, diagnostic := range diagnostics {
+			fmt.Println(diagnostic.String())
+		}
+		fmt.Println(strconv.Itoa(len(configs)+len(fileErrors)) + " configurations checked, " +
+			strconv.Itoa(len(diagnostics)) + " problems found")
+	}
+	if len(diagnostics) > 0 {
+		os.Exit(1)
+	}
+}
+
+func printLintJSON(value interface{}) {
+	// keep the element names in the messages readable
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		utils.HandleErrorAndExit("Error formatting the problems.", err)
+	}
+	fmt.Print(buffer.String())
+}
@@ -50,10 +50,11 @@ var localEntryShowCmd = &cobra.Command{
 
 func init() {
 	localEntryCmd.AddCommand(localEntryShowCmd)
+	addListFlags(localEntryShowCmd)
 	localEntryShowCmd.SetHelpTemplate(showLocalEntryCmdLongDesc +
 		utils.GetCmdUsage(programName, localEntryCmdLiteral,
 			utils.ShowCommand, "[localentry-name]") + showLocalEntryCmdExamples +
-		utils.GetCmdFlags(localEntryCmdLiteral))
+		utils.GetListCmdFlags(localEntryCmdLiteral, &artifactUtils.LocalEntryList{}))
 }
 
 // localentry argument handling method
@@ -76,7 +77,7 @@ func handleLocalEntryCmdArguments(args []string) {
 
 func printLocalEntryHelp() {
 	fmt.Print(showLocalEntryCmdLongDesc + utils.GetCmdUsage(programName, localEntryCmdLiteral, utils.ShowCommand,
-		"[localentry-name]") + showLocalEntryCmdExamples + utils.GetCmdFlags(localEntryCmdLiteral))
+		"[localentry-name]") + showLocalEntryCmdExamples + utils.GetListCmdFlags(localEntryCmdLiteral, &artifactUtils.LocalEntryList{}))
 }
 
 func executeGetLocalEntryCmd(localEntryName string) {
@@ -105,7 +106,7 @@ func executeListLocalEntryCmd() {
 	if err == nil {
 		// Printing the list of available Local Entries
 		list := resp.(*artifactUtils.LocalEntryList)
-		utils.PrintItemList(list, []string{utils.Name, utils.Type}, "No Local Entries found")
+		utils.PrintItemList(list, "No Local Entries found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Message Stores", err)
 	}
@@ -61,8 +61,9 @@ func init() {
     logsShowCmd.Flags().StringP("path", "p", ".", "Path the file should be downloaded")
     logsShowCmd.SetHelpTemplate(showLogsCmdLongDesc + utils.GetCmdUsage(programName, logsCmdLiteral,
         showLogsCmdLiteral, "[file-name] --path=[download-location]") +
-        showLogsCmdExamples + utils.GetCmdFlags(showLogsCmdLiteral))
+        showLogsCmdExamples + utils.GetListCmdFlags(showLogsCmdLiteral, &artifactUtils.LogFileList{}))
     logsCmd.AddCommand(logsShowCmd)
+    addListFlags(logsShowCmd)
 }
 
 func handleLogsCmdArguments(args []string, targetPath string) {
@@ -84,7 +85,7 @@ func handleLogsCmdArguments(args []string, targetPath string) {
 
 func printLogsHelp() {
     fmt.Print(showLogsCmdLongDesc + utils.GetCmdUsage(programName, logsCmdLiteral, showLogsCmdLiteral,
-        "[file-name] --path=[download-location]") + showLogsCmdExamples + utils.GetCmdFlags(logsCmdLiteral))
+        "[file-name] --path=[download-location]") + showLogsCmdExamples + utils.GetListCmdFlags(logsCmdLiteral, &artifactUtils.LogFileList{}))
 }
 
 func executeGetLogsCmd(filename string, targetPath string) {
@@ -105,7 +106,7 @@ func executeListLogsCmd() {
             }
         }
         filteredList.Count = int32(len(filteredList.LogFiles))
-        utils.PrintItemList(filteredList, []string{utils.Name, utils.Size}, "No log files found")
+        utils.PrintItemList(filteredList, "No log files found")
     } else {
         utils.Logln(utils.LogPrefixError+"Getting List of log files", err)
     }
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+// Log level export command related usage info
+const exportLogLevelCmdLiteral = "export"
+const exportLogLevelCmdShortDesc = "Export the levels of all the loggers to a file"
+
+const exportLogLevelCmdLongDesc = "Capture the level and the class of every logger of the current Micro Integrator " +
+	"into the YAML\nfile given by [file-path]. The file can be imported to another Micro Integrator with " +
+	logLevelCmdLiteral + " import\nor applied with " + applyCmdLiteral + "\n"
+
+var exportLogLevelCmdUsage = "Usage:\n" +
+	"  " + programName + " " + logLevelCmdLiteral + " " + exportLogLevelCmdLiteral + " [file-path]\n\n"
+
+var exportLogLevelCmdExamples = "Example:\n" +
+	"To export the logging profile of the staging server\n" +
+	"  " + programName + " remote select staging\n" +
+	"  " + programName + " " + logLevelCmdLiteral + " " + exportLogLevelCmdLiteral + " loggers.yaml\n\n"
+
+var exportLogLevelCmdHelpString = exportLogLevelCmdLongDesc + exportLogLevelCmdUsage + exportLogLevelCmdExamples +
+	utils.GetCmdFlags(exportLogLevelCmdLiteral)
+
+// loggerExportCmd represents the log-level export command
+var loggerExportCmd = &cobra.Command{
+	Use:   exportLogLevelCmdLiteral,
+	Short: exportLogLevelCmdShortDesc,
+	Long:  exportLogLevelCmdLongDesc + exportLogLevelCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleExportLoggerCmdArguments(args)
+	},
+}
+
+func init() {
+	logLevelCmd.AddCommand(loggerExportCmd)
+	loggerExportCmd.SetHelpTemplate(exportLogLevelCmdHelpString)
+}
+
+func handleExportLoggerCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Export Logger called")
+	if len(args) == 1 {
+		if args[0] == utils.HelpCommand {
+			printExportLoggerHelp()
+		} else {
+			executeExportLoggerCmd(args[0])
+		}
+	} else {
+		fmt.Println(programName, "log-level export requires 1 argument. See the usage below")
+		printExportLoggerHelp()
+	}
+}
+
+func printExportLoggerHelp() {
+	fmt.Print(exportLogLevelCmdHelpString)
+}
+
+func executeExportLoggerCmd(filePath string) {
+	remoteName := utils.RemoteConfigData.CurrentRemote
+	profile, err := utils.FetchLoggerProfile(utils.RemoteConfigData.Remotes[remoteName])
+	if err != nil {
+		utils.HandleErrorAndExit("Error fetching the loggers of "+remoteName+".", err)
+	}
+	if err = utils.WriteLoggerProfile(filePath, profile); err != nil {
+		utils.HandleErrorAndExit("Error writing the loggers to "+filePath+".", err)
+	}
+	fmt.Println("Exported " + strconv.Itoa(len(profile.Loggers)) + " loggers of " + remoteName + " to " + filePath)
+}
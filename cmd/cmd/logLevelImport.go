@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+// Log level import command related usage info
+const importLogLevelCmdLiteral = "import"
+const importLogLevelCmdShortDesc = "Import the levels of loggers from a file"
+
+const importLogLevelCmdLongDesc = "Restore the loggers exported with " + logLevelCmdLiteral + " export to the " +
+	"current Micro Integrator. Missing loggers\nare added and loggers with a different level are updated, after " +
+	"printing the changes and asking\nfor confirmation. Loggers which are not in the file are left as they are\n"
+
+var importLogLevelCmdUsage = "Usage:\n" +
+	"  " + programName + " " + logLevelCmdLiteral + " " + importLogLevelCmdLiteral + " [file-path]\n" +
+	"  " + programName + " " + logLevelCmdLiteral + " " + importLogLevelCmdLiteral + " [file-path] --dry-run\n\n"
+
+var importLogLevelCmdExamples = "Example:\n" +
+	"To copy the logging profile exported from the staging server to the production server\n" +
+	"  " + programName + " remote select prod\n" +
+	"  " + programName + " " + logLevelCmdLiteral + " " + importLogLevelCmdLiteral + " loggers.yaml\n\n" +
+	"To print the changes and the requests which would make them without sending them\n" +
+	"  " + programName + " " + logLevelCmdLiteral + " " + importLogLevelCmdLiteral + " loggers.yaml --dry-run\n\n"
+
+var importLogLevelCmdHelpString = importLogLevelCmdLongDesc + importLogLevelCmdUsage + importLogLevelCmdExamples +
+	utils.GetConfirmCmdFlags(importLogLevelCmdLiteral)
+
+// loggerImportCmd represents the log-level import command
+var loggerImportCmd = &cobra.Command{
+	Use:   importLogLevelCmdLiteral,
+	Short: importLogLevelCmdShortDesc,
+	Long:  importLogLevelCmdLongDesc + importLogLevelCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleImportLoggerCmdArguments(args)
+	},
+}
+
+func init() {
+	logLevelCmd.AddCommand(loggerImportCmd)
+	addConfirmFlag(loggerImportCmd)
+	loggerImportCmd.SetHelpTemplate(importLogLevelCmdHelpString)
+}
+
+func handleImportLoggerCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Import Logger called")
+	if len(args) == 1 {
+		if args[0] == utils.HelpCommand {
+			printImportLoggerHelp()
+		} else {
+			executeImportLoggerCmd(args[0])
+		}
+	} else {
+		fmt.Println(programName, "log-level import requires 1 argument. See the usage below")
+		printImportLoggerHelp()
+	}
+}
+
+func printImportLoggerHelp() {
+	fmt.Print(importLogLevelCmdHelpString)
+}
+
+func executeImportLoggerCmd(filePath string) {
+	profile, err := utils.ReadLoggerProfile(filePath)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the loggers.", err)
+	}
+	remoteName := utils.RemoteConfigData.CurrentRemote
+	state := utils.DesiredState{Loggers: profile.Loggers}
+	changes, errs := utils.PlanDesiredState(utils.RemoteConfigData.Remotes[remoteName], state)
+	for _, err := range errs {
+		fmt.Fprintln(os.Stderr, utils.LogPrefixError+err.Error())
+	}
+	if len(errs) > 0 {
+		utils.HandleErrorAndExit("Unable to import the loggers of "+filePath+" to "+remoteName+".", nil)
+	}
+
+	if len(changes) == 0 {
+		fmt.Println("The loggers of " + remoteName + " already match " + filePath + ", nothing to change")
+		return
+	}
+	fmt.Println("Changes to the loggers of " + remoteName + " (+ add, ~ change):")
+	for _, change := range changes {
+		fmt.Println("  " + describeImportLoggerChange(change, state))
+	}
+	fmt.Println()
+	utils.ConfirmCurrentRemoteOperation("apply "+strconv.Itoa(len(changes))+" logger changes", confirmYes)
+	failed := 0
+	for _, change := range changes {
+		if err := utils.ApplyDesiredStateChange(change, state); err != nil {
+			fmt.Fprintln(os.Stderr, utils.LogPrefixError+"logger "+change.Name+": "+err.Error())
+			failed++
+		} else {
+			fmt.Println(applyResultPrefix() + describeImportLoggerChange(change, state))
+		}
+	}
+	if utils.DryRun {
+		fmt.Println("Dry run, " + strconv.Itoa(len(changes)-failed) + " changes were not made, " +
+			strconv.Itoa(failed) + " failed")
+	} else {
+		fmt.Println(strconv.Itoa(len(changes)-failed) + " changes applied, " + strconv.Itoa(failed) + " failed")
+	}
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// describe a change with the level and the class of the added loggers
+func describeImportLoggerChange(change utils.InventoryChange, state utils.DesiredState) string {
+	if change.Kind == utils.InventoryChangeAdded {
+		logger := state.Loggers[change.Name]
+		return change.String() + " (level: " + logger.Level + ", class: " + logger.Class + ")"
+	}
+	return change.String()
+}
@@ -50,10 +50,11 @@ var messageProcessorShowCmd = &cobra.Command{
 
 func init() {
 	messageProcessorCmd.AddCommand(messageProcessorShowCmd)
+	addListFlags(messageProcessorShowCmd)
 	messageProcessorShowCmd.SetHelpTemplate(showMessageProcessorCmdLongDesc +
 		utils.GetCmdUsage(programName, messageProcessorCmdLiteral,
 			utils.ShowCommand, "[messageprocessor-name]") + showMessageProcessorCmdExamples +
-		utils.GetCmdFlags(messageProcessorCmdLiteral))
+		utils.GetListCmdFlags(messageProcessorCmdLiteral, &artifactUtils.MessageProcessorList{}))
 }
 
 // messageprocessor argument handling method
@@ -76,7 +77,7 @@ func handleMessageProcessorCmdArguments(args []string) {
 
 func printMessageProcessorHelp() {
 	fmt.Print(showMessageProcessorCmdLongDesc + utils.GetCmdUsage(programName, messageProcessorCmdLiteral, utils.ShowCommand,
-		"[messageprocessor-name]") + showMessageProcessorCmdExamples + utils.GetCmdFlags(messageProcessorCmdLiteral))
+		"[messageprocessor-name]") + showMessageProcessorCmdExamples + utils.GetListCmdFlags(messageProcessorCmdLiteral, &artifactUtils.MessageProcessorList{}))
 }
 
 func executeGetMessageProcessorCmd(messageProcessorName string) {
@@ -109,7 +110,7 @@ func executeListMessageProcessorCmd() {
 	if err == nil {
 		// Printing the list of available Endpoints
 		list := resp.(*artifactUtils.MessageProcessorList)
-		utils.PrintItemList(list, []string{utils.Name, utils.Type, utils.Status}, "No Message Processors Found")
+		utils.PrintItemList(list, "No Message Processors Found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Message Processors", err)
 	}
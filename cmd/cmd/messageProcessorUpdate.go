@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
 )
 
 var messageProcessorStateValue string
@@ -30,13 +31,20 @@ const updateMessageProcessorCmdLiteral = "update"
 const messageProcessorState = "state"
 const updateMessageProcessorCmdShortDesc = "Update state of a message processor"
 
-const updateMessageProcessorCmdLongDesc = "Activate and deactivate a given message processor \n"
+const updateMessageProcessorCmdLongDesc = "Activate and deactivate a given message processor \n" +
+	"Several message processors can be named, or selected with --filter or --from-file, to update them concurrently\n" +
+	"Deactivating asks for confirmation unless --yes is given\n"
 
 var updateMessageProcessorCmdUsage = "Usage:\n" +
-	"  " + programName + " " + messageProcessorCmdLiteral + " " + updateMessageProcessorCmdLiteral + " [messageprocessor-name] " + messageProcessorState + " [state]\n\n"
+	"  " + programName + " " + messageProcessorCmdLiteral + " " + updateMessageProcessorCmdLiteral + " [messageprocessor-name]... " + messageProcessorState + " [state]\n" +
+	"  " + programName + " " + messageProcessorCmdLiteral + " " + updateMessageProcessorCmdLiteral + " " + messageProcessorState + " [state] --filter [expression]\n" +
+	"  " + programName + " " + messageProcessorCmdLiteral + " " + updateMessageProcessorCmdLiteral + " " + messageProcessorState + " [state] --from-file [file-path]\n\n"
 
 var updateMessageProcessorCmdExamples = "Example:\n" +
-	"  " + programName + " " + messageProcessorCmdLiteral + " " + updateMessageProcessorCmdLiteral + " TestMessageProcessor state inactive\n\n"
+	"  " + programName + " " + messageProcessorCmdLiteral + " " + updateMessageProcessorCmdLiteral + " TestMessageProcessor state inactive\n" +
+	"  " + programName + " " + messageProcessorCmdLiteral + " " + updateMessageProcessorCmdLiteral + " TestMessageProcessor state inactive --yes\n" +
+	"To activate all the inactive message processors\n" +
+	"  " + programName + " " + messageProcessorCmdLiteral + " " + updateMessageProcessorCmdLiteral + " state active --filter status!=active\n\n"
 
 var updateMessageProcessorCmdHelpString = updateMessageProcessorCmdLongDesc + updateMessageProcessorCmdUsage + updateMessageProcessorCmdExamples
 
@@ -52,29 +60,28 @@ var messageProcessorUpdateCmd = &cobra.Command{
 
 func init() {
 	messageProcessorCmd.AddCommand(messageProcessorUpdateCmd)
-	messageProcessorUpdateCmd.SetHelpTemplate(updateMessageProcessorCmdHelpString + utils.GetCmdFlags(updateMessageProcessorCmdLiteral))
+	messageProcessorUpdateCmd.SetHelpTemplate(updateMessageProcessorCmdHelpString + utils.GetStateUpdateCmdFlags(updateMessageProcessorCmdLiteral, &artifactUtils.MessageProcessorList{}))
+	addStateUpdateFlags(messageProcessorUpdateCmd)
 }
 
 func handleUpdateMessageProcessorCmdArguments(args []string) {
 	utils.Logln(utils.LogPrefixInfo + "Update message processor called")
-	if len(args) == 3 {
-		if args[0] == "help" || args[1] == "help" || args[2] == "help" {
-			printUpdateMessageProcessorHelp()
-		} else if args[1] != messageProcessorState {
-			printInvalidCommandMessage(args)
-		} else {
-			messageProcessorName = args[0]
-			messageProcessorStateValue = args[2]
-			executeUpdateMessageProcessorCmd(messageProcessorName, messageProcessorStateValue)
-		}
-	} else {
+	if utils.ContainsString(args, "help") {
+		printUpdateMessageProcessorHelp()
+	} else if names, state, valid := parseStateUpdateArgs(args, messageProcessorState); !valid {
 		printInvalidCommandMessage(args)
+	} else if isSingleStateUpdate(names) {
+		messageProcessorName = names[0]
+		messageProcessorStateValue = state
+		executeUpdateMessageProcessorCmd(messageProcessorName, messageProcessorStateValue)
+	} else {
+		executeBulkStateUpdate(messageProcessorCmdLiteral, "message processor", names, state)
 	}
 }
 
 func printInvalidCommandMessage(args []string) {
 	fmt.Println("messageprocessor update:", args, "is not a valid command.\n" +
-		programName, "messageprocessor update requires 3 arguments. See the usage below.")
+		programName, "messageprocessor update requires message processor names, --filter or --from-file followed by state [state]. See the usage below.")
 	printUpdateMessageProcessorHelp()
 }
 func printUpdateMessageProcessorHelp() {
@@ -82,6 +89,9 @@ func printUpdateMessageProcessorHelp() {
 }
 
 func executeUpdateMessageProcessorCmd(messageProcessorName, messageProcessorStateValue string) {
+	if utils.IsStateReducing(messageProcessorStateValue) {
+		utils.ConfirmCurrentRemoteOperation("deactivate message processor "+messageProcessorName, confirmYes)
+	}
 	resp, err := utils.UpdateMIMessageProcessor(messageProcessorName, messageProcessorStateValue)
 
 	if err != nil {
@@ -51,10 +51,11 @@ var messageStoreShowCmd = &cobra.Command{
 
 func init() {
 	messageStoreCmd.AddCommand(messageStoreShowCmd)
+	addListFlags(messageStoreShowCmd)
 	messageStoreShowCmd.SetHelpTemplate(showMessageStoreCmdLongDesc +
 		utils.GetCmdUsage(programName, messageStoreCmdLiteral,
 			utils.ShowCommand, "[messagestore-name]") + showMessageStoreCmdExamples +
-		utils.GetCmdFlags(messageStoreCmdLiteral))
+		utils.GetListCmdFlags(messageStoreCmdLiteral, &artifactUtils.MessageStoreList{}))
 }
 
 // messagestore argument handling method
@@ -77,7 +78,7 @@ func handleMessageStoreCmdArguments(args []string) {
 
 func printMessageStoreHelp() {
 	fmt.Print(showMessageStoreCmdLongDesc + utils.GetCmdUsage(programName, messageStoreCmdLiteral, utils.ShowCommand,
-		"[messagestore-name]") + showMessageStoreCmdExamples + utils.GetCmdFlags(messageStoreCmdLiteral))
+		"[messagestore-name]") + showMessageStoreCmdExamples + utils.GetListCmdFlags(messageStoreCmdLiteral, &artifactUtils.MessageStoreList{}))
 }
 
 func executeGetMessageStoreCmd(messageStoreName string) {
@@ -110,7 +111,7 @@ func executeListMessageStoreCmd() {
 	if err == nil {
 		// Printing the list of available Message Stores
 		list := resp.(*artifactUtils.MessageStoreList)
-		utils.PrintItemList(list, []string{utils.Name, utils.Type, utils.Size}, "No Message Stores found")
+		utils.PrintItemList(list, "No Message Stores found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Message Stores", err)
 	}
@@ -52,8 +52,9 @@ var proxyServiceShowCmd = &cobra.Command{
 
 func init() {
 	proxyServiceCmd.AddCommand(proxyServiceShowCmd)
+	addListFlags(proxyServiceShowCmd)
 	proxyServiceShowCmd.SetHelpTemplate(showProxyServiceCmdLongDesc + utils.GetCmdUsage(programName, proxyServiceCmdLiteral,
-		showProxyServiceCmdLiteral, "[proxy-name]") + showProxyServiceCmdExamples + utils.GetCmdFlags(proxyServiceCmdLiteral))
+		showProxyServiceCmdLiteral, "[proxy-name]") + showProxyServiceCmdExamples + utils.GetListCmdFlags(proxyServiceCmdLiteral, &artifactUtils.ProxyServiceList{}))
 }
 
 func handleProxyServiceCmdArguments(args []string) {
@@ -75,7 +76,7 @@ func handleProxyServiceCmdArguments(args []string) {
 
 func printProxyServiceHelp() {
 	fmt.Print(showProxyServiceCmdLongDesc + utils.GetCmdUsage(programName, proxyServiceCmdLiteral, showProxyServiceCmdLiteral,
-		"[proxy-name]") + showProxyServiceCmdExamples + utils.GetCmdFlags(proxyServiceCmdLiteral))
+		"[proxy-name]") + showProxyServiceCmdExamples + utils.GetListCmdFlags(proxyServiceCmdLiteral, &artifactUtils.ProxyServiceList{}))
 }
 
 func executeGetProxyServiceCmd(proxyServiceName string) {
@@ -114,7 +115,7 @@ func executeListProxyServicesCmd() {
 	if err == nil {
 		// Printing the list of available Endpoints
 		list := resp.(*artifactUtils.ProxyServiceList)
-		utils.PrintItemList(list, []string{utils.Name, utils.Wsdl11, utils.Wsdl20}, "No Proxy Services found")
+		utils.PrintItemList(list, "No Proxy Services found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Proxy Services", err)
 	}
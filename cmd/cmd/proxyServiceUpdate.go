@@ -22,21 +22,34 @@ import (
 	"github.com/spf13/cobra"
 	"fmt"
 	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
 )
 
 const updateProxyServiceCmdLiteral = "update"
 const proxyServiceState = "state"
-const updateProxyServiceCmdShortDesc = "Update state of a proxy service"
+const updateProxyServiceCmdShortDesc = "Update state, statistics or tracing of a proxy service"
 
-const updateProxyServiceCmdLongDesc = "Activate and Deactivate a specified proxy service \n"
+const updateProxyServiceCmdLongDesc = "Activate and Deactivate a specified proxy service, or enable and disable its statistics and tracing\n" +
+	"Several proxy services can be named, or selected with --filter or --from-file, to update them concurrently\n" +
+	"Deactivating asks for confirmation unless --yes is given\n"
 
 var updateProxyServiceCmdUsage = "Usage:\n" +
-	"  " + programName + " " + proxyServiceCmdLiteral + " " + updateProxyServiceCmdLiteral + " [proxy-name] " + proxyServiceState + " [state]\n\n"
+	"  " + programName + " " + proxyServiceCmdLiteral + " " + updateProxyServiceCmdLiteral + " [proxy-name]... " + proxyServiceState + " [state]\n" +
+	"  " + programName + " " + proxyServiceCmdLiteral + " " + updateProxyServiceCmdLiteral + " [proxy-name]... [" + utils.ArtifactPropertyTracing + "|" + utils.ArtifactPropertyStatistics + "] [enable|disable]\n" +
+	"  " + programName + " " + proxyServiceCmdLiteral + " " + updateProxyServiceCmdLiteral + " " + proxyServiceState + " [state] --filter [expression]\n" +
+	"  " + programName + " " + proxyServiceCmdLiteral + " " + updateProxyServiceCmdLiteral + " " + proxyServiceState + " [state] --from-file [file-path]\n\n"
 
 var updateProxyServiceCmdExamples = "Example:\n" +
-	"  " + programName + " " + proxyServiceCmdLiteral + " " + updateProxyServiceCmdLiteral + " testProxyService state inactive\n\n"
+	"  " + programName + " " + proxyServiceCmdLiteral + " " + updateProxyServiceCmdLiteral + " testProxyService state inactive\n" +
+	"  " + programName + " " + proxyServiceCmdLiteral + " " + updateProxyServiceCmdLiteral + " testProxyService state inactive --yes\n" +
+	"To deactivate two proxy services\n" +
+	"  " + programName + " " + proxyServiceCmdLiteral + " " + updateProxyServiceCmdLiteral + " OrderProxy StockQuoteProxy state inactive\n" +
+	"To activate the proxy services listed in a file\n" +
+	"  " + programName + " " + proxyServiceCmdLiteral + " " + updateProxyServiceCmdLiteral + " state active --from-file proxies.txt\n" +
+	"To disable statistics of the proxy services whose names start with order\n" +
+	"  " + programName + " " + proxyServiceCmdLiteral + " " + updateProxyServiceCmdLiteral + " statistics disable --filter 'name~^order'\n\n"
 
-var updateProxyServiceCmdHelpString = updateProxyServiceCmdShortDesc + updateProxyServiceCmdUsage + updateProxyServiceCmdExamples
+var updateProxyServiceCmdHelpString = updateProxyServiceCmdLongDesc + updateProxyServiceCmdUsage + updateProxyServiceCmdExamples
 
 var proxyServiceUpdateCmd = &cobra.Command{
 	Use:   updateProxyServiceCmdLiteral,
@@ -49,26 +62,29 @@ var proxyServiceUpdateCmd = &cobra.Command{
 
 func init() {
 	proxyServiceCmd.AddCommand(proxyServiceUpdateCmd)
-	proxyServiceUpdateCmd.SetHelpTemplate(updateProxyServiceCmdHelpString + utils.GetCmdFlags(updateProxyServiceCmdLiteral))
+	proxyServiceUpdateCmd.SetHelpTemplate(updateProxyServiceCmdHelpString + utils.GetStateUpdateCmdFlags(updateProxyServiceCmdLiteral, &artifactUtils.ProxyServiceList{}))
+	addStateUpdateFlags(proxyServiceUpdateCmd)
 }
 
 func handleUpdateProxyServiceCmdArguments(args []string) {
-	if len(args) == 3 {
-		if args[0] == "help" || args[1] == "help" || args[2] == "help" {
-			printUpdateProxyServiceHelp()
-		} else if args[1] != proxyServiceState {
-			printInvalidProxyUpdateCmdMessage(args)
-		} else {
-			updateProxyServiceState(args[0], args[2])
-		}
-	} else {
+	if utils.ContainsString(args, "help") {
+		printUpdateProxyServiceHelp()
+	} else if names, property, state, valid := parseArtifactUpdateArgs(args, proxyServiceState,
+		utils.ArtifactPropertyTracing, utils.ArtifactPropertyStatistics); !valid {
 		printInvalidProxyUpdateCmdMessage(args)
+	} else if property != proxyServiceState {
+		executePropertyUpdate(proxyServiceCmdLiteral, "proxy service", names, property, state)
+	} else if isSingleStateUpdate(names) {
+		updateProxyServiceState(names[0], state)
+	} else {
+		executeBulkStateUpdate(proxyServiceCmdLiteral, "proxy service", names, state)
 	}
 }
 
 func printInvalidProxyUpdateCmdMessage(args []string) {
 	fmt.Println("proxyservice update:", args, "is not a valid command.\n" +
-		programName, "proxyservice update requires 3 arguments. See the usage below.")
+		programName, "proxyservice update requires proxy service names, --filter or --from-file followed by state [state], " +
+		"tracing [enable|disable] or statistics [enable|disable]. See the usage below.")
 	printUpdateProxyServiceHelp()
 }
 
@@ -77,6 +93,9 @@ func printUpdateProxyServiceHelp()  {
 }
 
 func updateProxyServiceState(proxyName string, intendedState string) {
+	if utils.IsStateReducing(intendedState) {
+		utils.ConfirmCurrentRemoteOperation("deactivate proxy service "+proxyName, confirmYes)
+	}
 	resp, err := utils.UpdateMIProxySerice(proxyName, intendedState)
 	if err != nil {
 		fmt.Println(utils.LogPrefixError + "Updating state of proxy service failed: ", err)
@@ -68,12 +68,12 @@ var remoteCmd = &cobra.Command{
 	Short: remoteCmdShortDesc,
 	Long:  remoteCmdLongDesc,
 	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Print(remoteCmdLongDesc + remoteUsage + utils.GetCmdFlags("remote") + remoteCmdExamples)
+		fmt.Print(remoteCmdLongDesc + remoteUsage + utils.GetOfflineCmdFlags("remote") + remoteCmdExamples)
 	},
 	ValidArgs: remoteCmdValidArgs,
 }
 
 func init() {
 	RootCmd.AddCommand(remoteCmd)
-	remoteCmd.SetHelpTemplate(remoteCmdLongDesc + remoteUsage + utils.GetCmdFlags("remote") + remoteCmdExamples)
+	remoteCmd.SetHelpTemplate(remoteCmdLongDesc + remoteUsage + utils.GetOfflineCmdFlags("remote") + remoteCmdExamples)
 }
@@ -35,7 +35,8 @@ Usage:
 `)
 
 var logoutCmdExamples = "Example:\n" +
-	" " + programName + " " + remoteCmdLiteral + " " + logoutCmdLiteral + "\n\n"
+	" " + programName + " " + remoteCmdLiteral + " " + logoutCmdLiteral + "\n" +
+	" " + programName + " " + remoteCmdLiteral + " " + logoutCmdLiteral + " --yes\n\n"
 
 var remoteLogoutCmdHelpString = loginCmdShortDesc + remoteLogoutUsage + logoutCmdExamples
 
@@ -51,6 +52,7 @@ var logoutCmd = &cobra.Command{
 }
 
 func executeLogoutCmd() {
+	utils.ConfirmCurrentRemoteOperation("log out", confirmYes)
 	// call the logout resource of MI management API
 	url := utils.GetRESTAPIBase() + utils.LogoutResource
 	headers := make(map[string]string)
@@ -60,7 +62,9 @@ func executeLogoutCmd() {
 	if err != nil {
 		utils.HandleErrorAndExit("Error logging out of the current remote", err)
 	} else {
-		if resp.StatusCode() == http.StatusOK {
+		if resp.StatusCode() == http.StatusOK && utils.DryRun {
+			fmt.Println("Dry run, not logged out of the current remote: " + utils.RemoteConfigData.CurrentRemote)
+		} else if resp.StatusCode() == http.StatusOK {
 			fmt.Println("Successfully logged out of the current remote: " + utils.RemoteConfigData.CurrentRemote)
 		} else {
 			utils.HandleErrorAndExit("Error logging out of the current remote: "+resp.Status(), nil)
@@ -71,5 +75,6 @@ func executeLogoutCmd() {
 func init() {
 	remoteCmd.AddCommand(logoutCmd)
 	logoutCmd.SetHelpTemplate(logoutCmdShortDesc + utils.GetCmdUsageForNonArguments(programName,  remoteCmdLiteral, logoutCmdLiteral) +
-		logoutCmdExamples + utils.GetCmdFlags(remoteCmdLiteral))
+		logoutCmdExamples + utils.GetConfirmCmdFlags(logoutCmdLiteral))
+	addConfirmFlag(logoutCmd)
 }
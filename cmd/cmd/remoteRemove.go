@@ -37,9 +37,11 @@ Usage:
 var remoteRemoveCmdExamples = dedent.Dedent(`
 Example:
   ` + programName + ` ` + remoteCmdLiteral + ` ` + remoteRemoveCmdLiteral + ` TestServer` + `
+  ` + programName + ` ` + remoteCmdLiteral + ` ` + remoteRemoveCmdLiteral + ` TestServer --yes` + `
 `)
 
-var remoteRemoveCmdHelpString = remoteRemoveCmdLongDesc + remoteRemoveUsage + remoteRemoveCmdExamples
+var remoteRemoveCmdHelpString = remoteRemoveCmdLongDesc + remoteRemoveUsage + remoteRemoveCmdExamples +
+	utils.GetOfflineConfirmCmdFlags(remoteRemoveCmdLiteral)
 
 var remoteRemoveCmd = &cobra.Command{
 	Use:   remoteRemoveCmdLiteral,
@@ -70,10 +72,18 @@ func handleServerRemoveCmdArguments(args []string) {
 }
 
 func executeServerRemoveCmd(args []string) {
+	if remote, exists := utils.RemoteConfigData.Remotes[args[0]]; exists && args[0] != utils.DefaultRemoteName {
+		utils.ConfirmOperation(utils.Confirmation{Action: "remove it from the CLI configuration",
+			RemoteName: args[0], Remote: remote}, confirmYes)
+	}
 	var result = utils.RemoteConfigData.RemoveRemote(args[0])
 	if result != nil {
 		utils.HandleErrorAndExit("Error: ", result)
 	}
+	if utils.DryRun {
+		fmt.Println("Would remove remote " + args[0] + " from the CLI configuration")
+		return
+	}
 	utils.RemoteConfigData.Persist(utils.GetRemoteConfigFilePath())
 }
 
@@ -83,5 +93,6 @@ func printServerRemoveHelp() {
 
 func init() {
 	remoteCmd.AddCommand(remoteRemoveCmd)
+	addConfirmFlag(remoteRemoveCmd)
 	remoteRemoveCmd.SetHelpTemplate(remoteRemoveCmdHelpString)
 }
@@ -44,9 +44,10 @@ var connectorShowCmd = &cobra.Command{
 
 func init() {
 	connectorCmd.AddCommand(connectorShowCmd)
+	addListFlags(connectorShowCmd)
 	connectorShowCmd.SetHelpTemplate(showConnectorsCmdLongDesc +
 		utils.GetCmdUsageForNonArguments(programName, connectorCmdLiteral, utils.ShowCommand) +
-		showConnectorCmdExamples + utils.GetCmdFlags(connectorCmdLiteral))
+		showConnectorCmdExamples + utils.GetListCmdFlags(connectorCmdLiteral, &artifactUtils.ConnectorList{}))
 }
 
 // connector argument handling method
@@ -74,8 +75,7 @@ func executeListConnectorCmd() {
 	if err == nil {
 		// Printing the list of available Connectors
 		list := resp.(*artifactUtils.ConnectorList)
-		utils.PrintItemList(list, []string{utils.Name, utils.Status, utils.Package, utils.Description},
-			"No Connectors found")
+		utils.PrintItemList(list, "No Connectors found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Connectors", err)
 	}
@@ -83,5 +83,5 @@ func executeListConnectorCmd() {
 
 func printConnectorHelp() {
 	fmt.Print(showConnectorsCmdLongDesc + utils.GetCmdUsageForNonArguments(programName, connectorCmdLiteral,
-		utils.ShowCommand) + showConnectorCmdExamples + utils.GetCmdFlags(connectorCmdLiteral))
+		utils.ShowCommand) + showConnectorCmdExamples + utils.GetListCmdFlags(connectorCmdLiteral, &artifactUtils.ConnectorList{}))
 }
@@ -23,11 +23,14 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-mi-tooling/cmd/utils"
 	"os"
+	"strings"
 	"time"
 )
 
 var cfgFile string
 var verbose bool
+var dryRun bool
+var confirmYes bool
 
 var programName = os.Args[0]
 
@@ -54,8 +57,16 @@ func Execute() {
 
 func init() {
 	cobra.OnInitialize(initConfig)
+	RootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		// the debug session commands report the expired sessions themselves
+		if cmd != debugSessionCmd && cmd.Parent() != debugSessionCmd {
+			warnExpiredDebugSessions()
+		}
+	}
 
 	RootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose mode")
+	RootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false,
+		"Print the requests changing the server instead of sending them")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -69,4 +80,41 @@ func initConfig() {
 	} else {
 		utils.IsVerbose = false
 	}
+
+	if dryRun {
+		utils.EnableDryRunMode()
+	}
+}
+
+// addConfirmFlag adds the flag which skips the confirmation prompt of a command
+func addConfirmFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&confirmYes, "yes", "y", false, "Skip the confirmation prompt")
+}
+
+// addListFlags adds the flags which control how artifact lists are printed
+func addListFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&utils.ListTableOptions.Columns, "columns", nil,
+		"Comma separated list of columns to print")
+	cmd.Flags().StringArrayVar(&utils.ListTableOptions.Filters, "filter", nil,
+		"Filter the list by [field][operator][value], e.g. name~^order or size>100")
+	cmd.Flags().StringVar(&utils.ListTableOptions.SortBy, "sort-by", "",
+		"Column to sort the list by. Prefix with '-' to sort in descending order")
+	cmd.Flags().BoolVar(&utils.ListTableOptions.NoHeaders, "no-headers", false, "Do not print the column headers")
+	cmd.Flags().BoolVar(&utils.ListTableOptions.Wide, "wide", false, "Print all the columns without truncating values")
+	cmd.Flags().BoolVar(&utils.ListWatchOptions.Enabled, "watch", false, "Re-fetch and redraw the output periodically")
+	cmd.Flags().DurationVar(&utils.ListWatchOptions.Interval, "interval", utils.DefaultWatchInterval,
+		"Time between two fetches of the watch mode")
+	cmd.Flags().StringVar(&utils.ListWatchOptions.Until, "until", "",
+		"Stop watching once every listed artifact satisfies the condition, e.g. size=0")
+
+	run := cmd.Run
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		if utils.ListWatchOptions.Enabled {
+			utils.WatchList(utils.ProjectName+" "+strings.Join(os.Args[1:], " "), func() {
+				run(cmd, args)
+			})
+		} else {
+			run(cmd, args)
+		}
+	}
 }
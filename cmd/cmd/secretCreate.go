@@ -84,7 +84,7 @@ var secretCreateCmd = &cobra.Command{
 func init() {
 	secretCmd.AddCommand(secretCreateCmd)
 	secretCreateCmd.SetHelpTemplate(secretCreateCmdLongDesc + utils.GetCmdUsageForArgsOnly(programName, secretCmdLiteral,
-		secretCreateCmdLiteral, secretCreateCmdArgs) + secretCreateCmdExamples + utils.GetCmdFlags(secretCmdLiteral))
+		secretCreateCmdLiteral, secretCreateCmdArgs) + secretCreateCmdExamples + utils.GetOfflineCmdFlags(secretCmdLiteral))
 	secretCreateCmd.Flags().StringP("file", "f", "", "from file")
 	secretCreateCmd.Flags().StringP("cipher", "c", "", "algorithm")
 }
@@ -108,7 +108,7 @@ func handleSecretCmdArguments(args []string) {
 		} else {
 			fmt.Println("Invalid number of arguments. See the usage guide.\n\n" +
 				utils.GetCmdUsageForArgsOnly(programName, secretCmdLiteral, secretCreateCmdLiteral, secretCreateCmdArgs) +
-				secretCreateCmdExamples + utils.GetCmdFlags(secretCmdLiteral))
+				secretCreateCmdExamples + utils.GetOfflineCmdFlags(secretCmdLiteral))
 		}
 }
 
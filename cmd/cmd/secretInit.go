@@ -52,7 +52,7 @@ var secretInitCmd = &cobra.Command{
 func init() {
 	secretCmd.AddCommand(secretInitCmd)
 	secretInitCmd.SetHelpTemplate(secretInitCmdLongDesc + utils.GetCmdUsage(programName, secretCmdLiteral,
-		secretInitCmdLiteral, "") + secretInitCmdExamples + utils.GetCmdFlags(secretCmdLiteral))
+		secretInitCmdLiteral, "") + secretInitCmdExamples + utils.GetOfflineCmdFlags(secretCmdLiteral))
 }
 
 func handleSecretInitCmdArgs(args []string) {
@@ -63,7 +63,7 @@ func handleSecretInitCmdArgs(args []string) {
 	if len(args) > 0 {
 		fmt.Println("Invalid number of arguments. See the usage guide.\n\n" +
 			utils.GetCmdUsage(programName, secretCmdLiteral, secretInitCmdLiteral, "") +
-			secretInitCmdExamples + utils.GetCmdFlags(secretCmdLiteral))
+			secretInitCmdExamples + utils.GetOfflineCmdFlags(secretCmdLiteral))
 	} else {
 		startConsoleForKeyStore(args)
 	}
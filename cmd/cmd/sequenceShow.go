@@ -51,8 +51,9 @@ var sequenceShowCmd = &cobra.Command{
 
 func init() {
 	sequenceCmd.AddCommand(sequenceShowCmd)
+	addListFlags(sequenceShowCmd)
 	sequenceShowCmd.SetHelpTemplate(showSequenceCmdLongDesc + utils.GetCmdUsage(programName, sequenceCmdLiteral,
-		showSequenceCmdLiteral, "[sequence-name]") + showSequenceCmdExamples + utils.GetCmdFlags(sequenceCmdLiteral))
+		showSequenceCmdLiteral, "[sequence-name]") + showSequenceCmdExamples + utils.GetListCmdFlags(sequenceCmdLiteral, &artifactUtils.SequenceList{}))
 }
 
 func handleSequenceCmdArguments(args []string) {
@@ -74,7 +75,7 @@ func handleSequenceCmdArguments(args []string) {
 
 func printSequenceHelp() {
 	fmt.Print(showSequenceCmdLongDesc + utils.GetCmdUsage(programName, sequenceCmdLiteral, showSequenceCmdLiteral,
-		"[sequence-name]") + showSequenceCmdExamples + utils.GetCmdFlags(sequenceCmdLiteral))
+		"[sequence-name]") + showSequenceCmdExamples + utils.GetListCmdFlags(sequenceCmdLiteral, &artifactUtils.SequenceList{}))
 }
 
 func executeGetSequenceCmd(sequencename string) {
@@ -124,7 +125,7 @@ func executeListSequencesCmd() {
 	if err == nil {
 		// Printing the list of available Sequences
 		list := resp.(*artifactUtils.SequenceList)
-		utils.PrintItemList(list, []string{utils.Name, utils.Stats, utils.Tracing}, "No sequences found")
+		utils.PrintItemList(list, "No sequences found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Sequences", err)
 	}
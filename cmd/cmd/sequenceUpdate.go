@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+const updateSequenceCmdLiteral = "update"
+const updateSequenceCmdShortDesc = "Update statistics or tracing of a sequence"
+
+const updateSequenceCmdLongDesc = "Enable and Disable statistics or tracing of a specified sequence\n" +
+	"Several sequences can be named, or selected with --filter or --from-file, to update them concurrently\n"
+
+var updateSequenceCmdUsage = "Usage:\n" +
+	"  " + programName + " " + sequenceCmdLiteral + " " + updateSequenceCmdLiteral + " [sequence-name]... [" + utils.ArtifactPropertyTracing + "|" + utils.ArtifactPropertyStatistics + "] [enable|disable]\n" +
+	"  " + programName + " " + sequenceCmdLiteral + " " + updateSequenceCmdLiteral + " [" + utils.ArtifactPropertyTracing + "|" + utils.ArtifactPropertyStatistics + "] [enable|disable] --filter [expression]\n" +
+	"  " + programName + " " + sequenceCmdLiteral + " " + updateSequenceCmdLiteral + " [" + utils.ArtifactPropertyTracing + "|" + utils.ArtifactPropertyStatistics + "] [enable|disable] --from-file [file-path]\n\n"
+
+var updateSequenceCmdExamples = "Example:\n" +
+	"  " + programName + " " + sequenceCmdLiteral + " " + updateSequenceCmdLiteral + " OrderSequence tracing enable\n" +
+	"  " + programName + " " + sequenceCmdLiteral + " " + updateSequenceCmdLiteral + " OrderSequence statistics disable\n" +
+	"To enable tracing of the sequences listed in a file\n" +
+	"  " + programName + " " + sequenceCmdLiteral + " " + updateSequenceCmdLiteral + " tracing enable --from-file sequences.txt\n\n"
+
+var updateSequenceCmdHelpString = updateSequenceCmdLongDesc + updateSequenceCmdUsage + updateSequenceCmdExamples
+
+var sequenceUpdateCmd = &cobra.Command{
+	Use:   updateSequenceCmdLiteral,
+	Short: updateSequenceCmdShortDesc,
+	Long:  updateSequenceCmdLongDesc + updateSequenceCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleUpdateSequenceCmdArguments(args)
+	},
+}
+
+func init() {
+	sequenceCmd.AddCommand(sequenceUpdateCmd)
+	sequenceUpdateCmd.SetHelpTemplate(updateSequenceCmdHelpString +
+		utils.GetPropertyUpdateCmdFlags(updateSequenceCmdLiteral, &artifactUtils.SequenceList{}))
+	addArtifactSelectorFlags(sequenceUpdateCmd)
+}
+
+func handleUpdateSequenceCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Update sequence called")
+	if utils.ContainsString(args, "help") {
+		printUpdateSequenceHelp()
+	} else if names, property, value, valid := parseArtifactUpdateArgs(args, utils.ArtifactPropertyTracing,
+		utils.ArtifactPropertyStatistics); !valid {
+		printInvalidSequenceUpdateCmdMessage(args)
+	} else {
+		executePropertyUpdate(sequenceCmdLiteral, "sequence", names, property, value)
+	}
+}
+
+func printInvalidSequenceUpdateCmdMessage(args []string) {
+	fmt.Println("sequence update:", args, "is not a valid command.\n"+
+		programName, "sequence update requires sequence names, --filter or --from-file followed by tracing [enable|disable] "+
+		"or statistics [enable|disable]. See the usage below.")
+	printUpdateSequenceHelp()
+}
+
+func printUpdateSequenceHelp() {
+	fmt.Println(updateSequenceCmdHelpString)
+}
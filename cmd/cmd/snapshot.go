@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Snapshot command related usage info
+const snapshotCmdLiteral = "snapshot"
+const snapshotCmdShortDesc = "Save and compare inventory snapshots of the Micro Integrator"
+const snapshotCmdLongDesc = "Save the full artifact inventory of the Micro Integrator to a file and compare " +
+	"snapshots taken at different points in time"
+
+// snapshotCmd represents the snapshot command
+var snapshotCmd = &cobra.Command{
+	Use:   snapshotCmdLiteral,
+	Short: snapshotCmdShortDesc,
+	Long:  snapshotCmdLongDesc,
+}
+
+func init() {
+	RootCmd.AddCommand(snapshotCmd)
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+const snapshotLive = "live"
+
+var snapshotDiffFormat string
+
+// summary of a snapshot printed with the JSON form of a diff
+type snapshotReference struct {
+	Source    string `json:"source"`
+	Remote    string `json:"remote"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type snapshotDiffResult struct {
+	From    snapshotReference       `json:"from"`
+	To      snapshotReference       `json:"to"`
+	Added   int                     `json:"added"`
+	Removed int                     `json:"removed"`
+	Changed int                     `json:"changed"`
+	Changes []utils.InventoryChange `json:"changes"`
+}
+
+// Snapshot diff command related usage info
+const snapshotDiffCmdLiteral = "diff"
+const snapshotDiffCmdShortDesc = "Compare two inventory snapshots"
+
+const snapshotDiffCmdLongDesc = "Report the artifacts and log levels which were added, removed or changed " +
+	"between the snapshot [file-a]\nand the snapshot [file-b], or the current state of the Micro Integrator " +
+	"when '" + snapshotLive + "' is given instead.\nExits with status 1 when differences are found\n"
+
+var snapshotDiffCmdUsage = "Usage:\n" +
+	"  " + programName + " " + snapshotCmdLiteral + " " + snapshotDiffCmdLiteral + " [file-a] [file-b]\n" +
+	"  " + programName + " " + snapshotCmdLiteral + " " + snapshotDiffCmdLiteral + " [file-a] " + snapshotLive +
+	"\n\n"
+
+var snapshotDiffCmdExamples = "Example:\n" +
+	"To compare two snapshots\n" +
+	"  " + programName + " " + snapshotCmdLiteral + " " + snapshotDiffCmdLiteral +
+	" before-release.json after-release.json\n\n" +
+	"To check whether anything changed since a snapshot was saved, in JSON form\n" +
+	"  " + programName + " " + snapshotCmdLiteral + " " + snapshotDiffCmdLiteral + " before-release.json " +
+	snapshotLive + " --format=json\n\n"
+
+var snapshotDiffCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + snapshotCmdLiteral + "\n" +
+	"      --format\t\tOutput format, text or json (default text)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n" +
+	"      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+
+var snapshotDiffCmdHelpString = snapshotDiffCmdLongDesc + snapshotDiffCmdUsage + snapshotDiffCmdExamples +
+	snapshotDiffCmdFlags
+
+// snapshotDiffCmd represents the snapshot diff command
+var snapshotDiffCmd = &cobra.Command{
+	Use:   snapshotDiffCmdLiteral,
+	Short: snapshotDiffCmdShortDesc,
+	Long:  snapshotDiffCmdLongDesc + snapshotDiffCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleSnapshotDiffCmdArguments(args)
+	},
+}
+
+func init() {
+	snapshotCmd.AddCommand(snapshotDiffCmd)
+	snapshotDiffCmd.Flags().StringVar(&snapshotDiffFormat, "format", utils.OutputFormatText,
+		"Output format, text or json")
+	snapshotDiffCmd.SetHelpTemplate(snapshotDiffCmdHelpString)
+}
+
+func handleSnapshotDiffCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Snapshot diff called")
+	if len(args) == 1 && args[0] == utils.HelpCommand {
+		printSnapshotDiffHelp()
+	} else if len(args) == 2 {
+		executeSnapshotDiffCmd(args[0], args[1])
+	} else {
+		fmt.Println(programName, "snapshot diff requires 2 arguments. See the usage below")
+		printSnapshotDiffHelp()
+	}
+}
+
+func printSnapshotDiffHelp() {
+	fmt.Print(snapshotDiffCmdHelpString)
+}
+
+func executeSnapshotDiffCmd(fromFile, to string) {
+	if snapshotDiffFormat != utils.OutputFormatText && snapshotDiffFormat != utils.OutputFormatJSON {
+		utils.HandleErrorAndExit("Invalid format.", errors.New("format must be "+utils.OutputFormatText+
+			" or "+utils.OutputFormatJSON))
+	}
+	from, err := utils.ReadInventory(fromFile)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the snapshot.", err)
+	}
+	var target utils.Inventory
+	if to == snapshotLive {
+		remoteName := utils.RemoteConfigData.CurrentRemote
+		target, err = utils.FetchInventory(remoteName, utils.RemoteConfigData.Remotes[remoteName])
+		if err != nil {
+			utils.HandleErrorAndExit("Error fetching the inventory of "+remoteName+".", err)
+		}
+	} else if target, err = utils.ReadInventory(to); err != nil {
+		utils.HandleErrorAndExit("Error reading the snapshot.", err)
+	}
+
+	result := snapshotDiffResult{
+		From:    snapshotReference{Source: fromFile, Remote: from.Remote, CreatedAt: from.CreatedAt},
+		To:      snapshotReference{Source: to, Remote: target.Remote, CreatedAt: target.CreatedAt},
+		Changes: utils.DiffInventories(from, target),
+	}
+	for _, change := range result.Changes {
+		switch change.Kind {
+		case utils.InventoryChangeAdded:
+			result.Added++
+		case utils.InventoryChangeRemoved:
+			result.Removed++
+		default:
+			result.Changed++
+		}
+	}
+
+	if snapshotDiffFormat == utils.OutputFormatJSON {
+		if result.Changes == nil {
+			result.Changes = []utils.InventoryChange{}
+		}
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			utils.HandleErrorAndExit("Error formatting the differences.", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		printSnapshotDiff(result)
+	}
+	if len(result.Changes) > 0 {
+		os.Exit(1)
+	}
+}
+
+func printSnapshotDiff(result snapshotDiffResult) {
+	fmt.Println("Comparing " + describeSnapshot(result.From) + " with " + describeSnapshot(result.To))
+	if len(result.Changes) == 0 {
+		fmt.Println("No differences found")
+		return
+	}
+	fmt.Println()
+	for _, change := range result.Changes {
+		fmt.Println(change.String())
+	}
+	fmt.Println()
+	fmt.Println(strconv.Itoa(result.Added) + " added, " + strconv.Itoa(result.Removed) + " removed, " +
+		strconv.Itoa(result.Changed) + " changed")
+}
+
+func describeSnapshot(snapshot snapshotReference) string {
+	return snapshot.Source + " (" + snapshot.Remote + ", " + snapshot.CreatedAt + ")"
+}
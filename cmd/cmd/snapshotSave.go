@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+// Snapshot save command related usage info
+const snapshotSaveCmdLiteral = "save"
+const snapshotSaveCmdShortDesc = "Save the inventory of the Micro Integrator to a file"
+
+const snapshotSaveCmdLongDesc = "Capture the full artifact inventory of the current Micro Integrator, with " +
+	"versions, states,\nstatistics and tracing flags, log levels and connector statuses, into the JSON file " +
+	"given by [file-name]\n"
+
+var snapshotSaveCmdUsage = "Usage:\n" +
+	"  " + programName + " " + snapshotCmdLiteral + " " + snapshotSaveCmdLiteral + " [file-name]\n\n"
+
+var snapshotSaveCmdExamples = "Example:\n" +
+	"To save a snapshot before a release\n" +
+	"  " + programName + " " + snapshotCmdLiteral + " " + snapshotSaveCmdLiteral + " before-release.json\n\n"
+
+// snapshotSaveCmd represents the snapshot save command
+var snapshotSaveCmd = &cobra.Command{
+	Use:   snapshotSaveCmdLiteral,
+	Short: snapshotSaveCmdShortDesc,
+	Long:  snapshotSaveCmdLongDesc + snapshotSaveCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleSnapshotSaveCmdArguments(args)
+	},
+}
+
+func init() {
+	snapshotCmd.AddCommand(snapshotSaveCmd)
+	snapshotSaveCmd.SetHelpTemplate(snapshotSaveCmdLongDesc + snapshotSaveCmdUsage + snapshotSaveCmdExamples +
+		utils.GetCmdFlags(snapshotCmdLiteral))
+}
+
+func handleSnapshotSaveCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Snapshot save called")
+	if len(args) == 1 {
+		if args[0] == utils.HelpCommand {
+			printSnapshotSaveHelp()
+		} else {
+			executeSnapshotSaveCmd(args[0])
+		}
+	} else {
+		fmt.Println(programName, "snapshot save requires 1 argument. See the usage below")
+		printSnapshotSaveHelp()
+	}
+}
+
+func printSnapshotSaveHelp() {
+	fmt.Print(snapshotSaveCmdLongDesc + snapshotSaveCmdUsage + snapshotSaveCmdExamples +
+		utils.GetCmdFlags(snapshotCmdLiteral))
+}
+
+func executeSnapshotSaveCmd(fileName string) {
+	remoteName := utils.RemoteConfigData.CurrentRemote
+	inventory, err := utils.FetchInventory(remoteName, utils.RemoteConfigData.Remotes[remoteName])
+	if err != nil {
+		utils.HandleErrorAndExit("Error fetching the inventory of "+remoteName+".", err)
+	}
+	if err = utils.WriteInventory(fileName, inventory); err != nil {
+		utils.HandleErrorAndExit("Error writing the snapshot to "+fileName+".", err)
+	}
+	count := 0
+	for _, items := range inventory.Artifacts {
+		count += len(items)
+	}
+	fmt.Println("Snapshot of " + remoteName + " with " + strconv.Itoa(count) + " artifacts and " +
+		strconv.Itoa(len(inventory.LogLevels)) + " log levels saved to " + fileName)
+}
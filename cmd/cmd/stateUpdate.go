@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+// selects the artifacts of the state update commands in addition to the names given as arguments
+var stateUpdateSelector utils.ArtifactSelector
+
+// addStateUpdateFlags adds the flags which select the artifacts of a state update command
+func addStateUpdateFlags(cmd *cobra.Command) {
+	addConfirmFlag(cmd)
+	addArtifactSelectorFlags(cmd)
+}
+
+// addArtifactSelectorFlags adds the flags which select the artifacts to update in addition to the named ones
+func addArtifactSelectorFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&stateUpdateSelector.Filters, "filter", nil,
+		"Select the artifacts to update by [field][operator][value], e.g. name~^order")
+	cmd.Flags().StringVar(&stateUpdateSelector.FromFile, "from-file", "",
+		"File listing the names of the artifacts to update, one per line")
+}
+
+// Parse the arguments of a state update command, [name]... state [state]
+// @return names given as arguments
+// @return intended state
+// @return false if the arguments are invalid or select nothing
+func parseStateUpdateArgs(args []string, stateLiteral string) ([]string, string, bool) {
+	names, _, state, valid := parseArtifactUpdateArgs(args, stateLiteral)
+	return names, state, valid
+}
+
+// Parse the arguments of an update command, [name]... [property] [value], where the property is one of the given
+// properties such as state or tracing
+// @return names given as arguments
+// @return updated property
+// @return intended value of the property
+// @return false if the arguments are invalid or select nothing
+func parseArtifactUpdateArgs(args []string, properties ...string) ([]string, string, string, bool) {
+	if len(args) < 2 || !utils.ContainsString(properties, args[len(args)-2]) {
+		return nil, "", "", false
+	}
+	names := args[:len(args)-2]
+	if len(names) == 0 && len(stateUpdateSelector.Filters) == 0 && stateUpdateSelector.FromFile == "" {
+		return nil, "", "", false
+	}
+	return names, args[len(args)-2], args[len(args)-1], true
+}
+
+// isSingleStateUpdate reports whether a state update names a single artifact and selects no others
+func isSingleStateUpdate(names []string) bool {
+	return len(names) == 1 && len(stateUpdateSelector.Filters) == 0 && stateUpdateSelector.FromFile == ""
+}
+
+// Update the states of the selected artifacts of a type on the current remote concurrently, printing the result
+// of each artifact. Exits with status 1 if any update fails.
+// @param artifactTypeName : name of the type of the artifacts
+// @param description : description of the type used in messages, such as proxy service
+// @param names : names given as arguments
+// @param state : intended state of the artifacts
+func executeBulkStateUpdate(artifactTypeName, description string, names []string, state string) {
+	artifactType, err := utils.GetArtifactType(artifactTypeName)
+	if err != nil {
+		utils.HandleErrorAndExit("Error updating the states of "+description+"s.", err)
+	}
+	remote := utils.RemoteConfigData.Remotes[utils.RemoteConfigData.CurrentRemote]
+	selector := stateUpdateSelector
+	selector.Names = names
+	selected, err := utils.SelectArtifactNames(remote, artifactType, selector)
+	if err != nil {
+		utils.HandleErrorAndExit("Error selecting the "+description+"s to update.", err)
+	}
+
+	if utils.IsStateReducing(state) {
+		utils.ConfirmCurrentRemoteOperation(getStateReductionVerb(state)+" "+countArtifacts(len(selected), description)+" ("+
+			strings.Join(selected, ", ")+")", confirmYes)
+	}
+	results := utils.RunBulkUpdate(selected, func(name string) (string, error) {
+		return utils.UpdateArtifactState(remote, artifactType, name, state)
+	})
+	printBulkUpdateResults(results, description)
+}
+
+// Enable or disable statistics or tracing of the selected artifacts of a type on the current remote. The message
+// of the server is printed for a single named artifact and the result of each artifact for several. Exits with
+// status 1 if any update fails.
+// @param artifactTypeName : name of the type of the artifacts
+// @param description : description of the type used in messages, such as proxy service
+// @param names : names given as arguments
+// @param property : tracing or statistics
+// @param value : enable or disable
+func executePropertyUpdate(artifactTypeName, description string, names []string, property, value string) {
+	artifactType, err := utils.GetArtifactType(artifactTypeName)
+	if err != nil {
+		utils.HandleErrorAndExit("Error updating the "+property+" of "+description+"s.", err)
+	}
+	if err = utils.CheckPropertyValue(property, value); err != nil {
+		utils.HandleErrorAndExit("Error updating the "+property+" of "+description+"s.", err)
+	}
+	remote := utils.RemoteConfigData.Remotes[utils.RemoteConfigData.CurrentRemote]
+	if isSingleStateUpdate(names) {
+		message, err := utils.UpdateArtifactProperty(remote, artifactType, names[0], property, value)
+		if err != nil {
+			utils.HandleErrorAndExit("Error updating the "+property+" of "+description+" "+names[0]+".", err)
+		}
+		fmt.Println(message)
+		return
+	}
+
+	selector := stateUpdateSelector
+	selector.Names = names
+	selected, err := utils.SelectArtifactNames(remote, artifactType, selector)
+	if err != nil {
+		utils.HandleErrorAndExit("Error selecting the "+description+"s to update.", err)
+	}
+	results := utils.RunBulkUpdate(selected, func(name string) (string, error) {
+		return utils.UpdateArtifactProperty(remote, artifactType, name, property, value)
+	})
+	printBulkUpdateResults(results, description)
+}
+
+// Print the result of each artifact of a bulk update followed by the number of updated and failed artifacts.
+// Exits with status 1 if any update failed.
+func printBulkUpdateResults(results []utils.BulkUpdateResult, description string) {
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Fprintln(os.Stderr, utils.LogPrefixError+result.Name+": "+result.Err.Error())
+			failed++
+		} else {
+			fmt.Println(result.Name + ": " + result.Message)
+		}
+	}
+	fmt.Println(countArtifacts(len(results)-failed, description) + " updated, " + strconv.Itoa(failed) + " failed")
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// count artifacts of a type in a message, such as 2 endpoints
+func countArtifacts(count int, description string) string {
+	if count == 1 {
+		return "1 " + description
+	}
+	return strconv.Itoa(count) + " " + description + "s"
+}
+
+// verb of an operation changing artifacts to a state reducing state, such as pause
+func getStateReductionVerb(state string) string {
+	if strings.EqualFold(state, utils.TaskStatePause) {
+		return utils.TaskStatePause
+	}
+	return "deactivate"
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+// Statistics command related usage info
+const statisticsCmdLiteral = "statistics"
+const statisticsCmdShortDesc = "Enable or disable statistics of artifacts of several types"
+
+var statisticsCmdHelpString = getArtifactsPropertyCmdHelp(statisticsCmdLiteral)
+
+var statisticsCmd = &cobra.Command{
+	Use:   statisticsCmdLiteral,
+	Short: statisticsCmdShortDesc,
+	Long:  statisticsCmdHelpString,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleArtifactsPropertyCmdArguments(utils.ArtifactPropertyStatistics, args)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(statisticsCmd)
+	addArtifactsPropertyFlags(statisticsCmd)
+	statisticsCmd.SetHelpTemplate(statisticsCmdHelpString)
+}
@@ -0,0 +1,221 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+var scheduleWindow time.Duration
+var scheduleOverlap time.Duration
+var taskTimeZone string
+var taskServerTimeZone string
+
+// largest number of fire times of a task listed in a schedule
+const maxScheduleFireTimesPerTask = 100
+
+const taskTimeFormat = "2006-01-02 15:04:05 MST"
+
+// Task schedule command related usage info
+const scheduleTaskCmdLiteral = "schedule"
+const scheduleTaskCmdShortDesc = "List the upcoming fire times of all the tasks"
+
+const scheduleTaskCmdLongDesc = "List the times at which the tasks fire within the window given by --window, " +
+	"computed locally from\ntheir Quartz cron expressions and intervals, and flag the tasks firing within --overlap " +
+	"of each other.\nCron expressions are evaluated in the time zone of the server given by --server-timezone. " +
+	"The first fire\ntime of an interval trigger is not reported by the server, so the fire times of interval " +
+	"triggers are\nestimated from now and marked with ~. Their overlaps cannot be computed, so the interval " +
+	"triggered tasks\nare listed once as not compared\n"
+
+var scheduleTaskCmdUsage = "Usage:\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + scheduleTaskCmdLiteral + "\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + scheduleTaskCmdLiteral + " --window [duration] --timezone [time-zone]\n\n"
+
+var scheduleTaskCmdExamples = "Example:\n" +
+	"To list the fire times of the next 24 hours\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + scheduleTaskCmdLiteral + "\n\n" +
+	"To list the fire times of the next week in UTC, for a server running in Berlin\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + scheduleTaskCmdLiteral + " --window 168h --timezone UTC " +
+	"--server-timezone Europe/Berlin\n\n"
+
+var taskTimeZoneFlags = "      --timezone\t\tTime zone to print the fire times in, such as UTC (default local)\n" +
+	"      --server-timezone\tTime zone of the server, in which cron expressions are evaluated (default local)\n"
+
+var scheduleTaskCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + scheduleTaskCmdLiteral + "\n" +
+	"      --window\t\tLength of the schedule to list (default 24h0m0s)\n" +
+	"      --overlap\t\tLargest time between the fire times of two tasks which overlap (default 1m0s)\n" +
+	taskTimeZoneFlags +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n" +
+	"      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+
+var scheduleTaskCmdHelpString = scheduleTaskCmdLongDesc + scheduleTaskCmdUsage + scheduleTaskCmdExamples +
+	scheduleTaskCmdFlags
+
+var taskScheduleCmd = &cobra.Command{
+	Use:   scheduleTaskCmdLiteral,
+	Short: scheduleTaskCmdShortDesc,
+	Long:  scheduleTaskCmdLongDesc + scheduleTaskCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleScheduleTaskCmdArguments(args)
+	},
+}
+
+func init() {
+	taskCmd.AddCommand(taskScheduleCmd)
+	taskScheduleCmd.Flags().DurationVar(&scheduleWindow, "window", 24*time.Hour, "Length of the schedule to list")
+	taskScheduleCmd.Flags().DurationVar(&scheduleOverlap, "overlap", time.Minute,
+		"Largest time between the fire times of two tasks which overlap")
+	addTaskTimeZoneFlags(taskScheduleCmd)
+	taskScheduleCmd.SetHelpTemplate(scheduleTaskCmdHelpString)
+}
+
+// addTaskTimeZoneFlags adds the flags which set the time zones of task fire times
+func addTaskTimeZoneFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&taskTimeZone, "timezone", "", "Time zone to print the fire times in, such as UTC")
+	cmd.Flags().StringVar(&taskServerTimeZone, "server-timezone", "",
+		"Time zone of the server, in which cron expressions are evaluated")
+}
+
+func handleScheduleTaskCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Task schedule called")
+	if len(args) == 1 && args[0] == utils.HelpCommand {
+		printScheduleTaskHelp()
+	} else if len(args) > 0 {
+		fmt.Println("Too many arguments. See the usage below")
+		printScheduleTaskHelp()
+	} else if scheduleWindow <= 0 || scheduleOverlap < 0 {
+		fmt.Println("The window should be positive and the overlap should not be negative. See the usage below")
+		printScheduleTaskHelp()
+	} else {
+		executeScheduleTaskCmd()
+	}
+}
+
+func printScheduleTaskHelp() {
+	fmt.Print(scheduleTaskCmdHelpString)
+}
+
+// Load the time zones given by the time zone flags
+// @return time zone to print the fire times in
+// @return time zone of the server
+func loadTaskTimeZones() (*time.Location, *time.Location) {
+	location, err := utils.LoadTimeZone(taskTimeZone)
+	if err != nil {
+		utils.HandleErrorAndExit("Invalid --timezone.", err)
+	}
+	serverLocation, err := utils.LoadTimeZone(taskServerTimeZone)
+	if err != nil {
+		utils.HandleErrorAndExit("Invalid --server-timezone.", err)
+	}
+	return location, serverLocation
+}
+
+func executeScheduleTaskCmd() {
+	location, serverLocation := loadTaskTimeZones()
+	remote := utils.RemoteConfigData.Remotes[utils.RemoteConfigData.CurrentRemote]
+	var tasks artifactUtils.TaskList
+	if err := utils.FetchRemoteData(remote, utils.PrefixTasks, nil, &tasks); err != nil {
+		utils.HandleErrorAndExit("Error getting the list of tasks.", err)
+	}
+
+	from := time.Now().In(location)
+	until := from.Add(scheduleWindow)
+	var fireTimes []utils.TaskFireTime
+	schedules := make(map[string]utils.TaskSchedule)
+	for _, task := range tasks.Tasks {
+		schedule, err := utils.NewTaskSchedule(task, serverLocation)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, utils.LogPrefixWarning+"Skipping task "+task.Name+": "+err.Error())
+			continue
+		}
+		schedules[task.Name] = schedule
+		times := schedule.FireTimes(from, until, maxScheduleFireTimesPerTask+1)
+		if len(times) > maxScheduleFireTimesPerTask {
+			fmt.Fprintln(os.Stderr, utils.LogPrefixWarning+"Task "+task.Name+" fires more than "+
+				strconv.Itoa(maxScheduleFireTimesPerTask)+" times in the window, only the first "+
+				strconv.Itoa(maxScheduleFireTimesPerTask)+" are listed")
+			times = times[:maxScheduleFireTimesPerTask]
+		}
+		for _, fireTime := range times {
+			fireTimes = append(fireTimes, utils.TaskFireTime{Task: task.Name, Time: fireTime,
+				Estimated: schedule.IsEstimated()})
+		}
+	}
+	sort.SliceStable(fireTimes, func(i, j int) bool { return fireTimes[i].Time.Before(fireTimes[j].Time) })
+	overlaps := utils.FindTaskOverlaps(fireTimes, scheduleOverlap)
+
+	fmt.Println("Tasks firing between " + from.Format(taskTimeFormat) + " and " + until.Format(taskTimeFormat))
+	if len(fireTimes) == 0 {
+		fmt.Println("No tasks fire in the window")
+		return
+	}
+	columns := []artifactUtils.Column{{Header: "TIME"}, {Header: "TASK"}, {Header: "TRIGGER"},
+		{Header: "OVERLAPS WITH"}}
+	var rows []artifactUtils.Row
+	for _, fireTime := range fireTimes {
+		row := artifactUtils.Row{Cells: []string{formatTaskFireTime(fireTime.Time, fireTime.Estimated),
+			fireTime.Task, schedules[fireTime.Task].String(), strings.Join(fireTime.OverlapsWith, ", ")}}
+		if len(fireTime.OverlapsWith) > 0 {
+			row.State = artifactUtils.RowStateWarning
+		}
+		rows = append(rows, row)
+	}
+	err := utils.RenderTable(os.Stdout, columns, rows, utils.TableOptions{Wide: true},
+		utils.TableStyle{Colorize: utils.IsTerminal(os.Stdout)})
+	if err != nil {
+		utils.HandleErrorAndExit("Error printing the schedule.", err)
+	}
+
+	if len(overlaps) > 0 {
+		fmt.Println()
+		fmt.Println("Tasks firing within " + scheduleOverlap.String() + " of each other:")
+		for _, overlap := range overlaps {
+			times := strconv.Itoa(overlap.Count) + " times"
+			if overlap.Count == 1 {
+				times = "once"
+			}
+			fmt.Println("  " + overlap.Tasks[0] + " and " + overlap.Tasks[1] + " overlap " + times +
+				", first at " + overlap.First.Format(taskTimeFormat))
+		}
+	}
+	if estimated := utils.GetEstimatedTasks(fireTimes); len(estimated) > 0 {
+		fmt.Println()
+		fmt.Println("Not compared, as the fire times of their interval triggers are estimated: " +
+			strings.Join(estimated, ", "))
+	}
+}
+
+// format a fire time, marking estimated times with ~
+func formatTaskFireTime(fireTime time.Time, estimated bool) string {
+	if estimated {
+		return "~" + fireTime.Format(taskTimeFormat)
+	}
+	return fireTime.Format(taskTimeFormat)
+}
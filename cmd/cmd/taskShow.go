@@ -20,12 +20,16 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-mi-tooling/cmd/utils"
 	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
 )
 
 var taskName string
+var taskNextFireTimes int
 
 // Show Task command related usage info
 const showTaskCmdLiteral = "show"
@@ -36,6 +40,8 @@ const showTaskCmdLongDesc = "Get information about the Task specified by command
 var showTaskCmdExamples = "Example:\n" +
 	"To get details about a specific task\n" +
 	"  " + programName + " " + taskCmdLiteral + " " + showTaskCmdLiteral + " SampleTask\n\n" +
+	"To get details about a specific task with its next 5 fire times in UTC\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + showTaskCmdLiteral + " SampleTask --next 5 --timezone UTC\n\n" +
 	"To list all the tasks\n" +
 	"  " + programName + " " + taskCmdLiteral + " " + showTaskCmdLiteral + "\n\n"
 
@@ -51,13 +57,19 @@ var taskShowCmd = &cobra.Command{
 
 func init() {
 	taskCmd.AddCommand(taskShowCmd)
+	addListFlags(taskShowCmd)
+	taskShowCmd.Flags().IntVar(&taskNextFireTimes, "next", 0, "Number of upcoming fire times of the task to print")
+	addTaskTimeZoneFlags(taskShowCmd)
 	taskShowCmd.SetHelpTemplate(showTaskCmdLongDesc + utils.GetCmdUsage(programName, taskCmdLiteral,
-		showTaskCmdLiteral, "[task-name]") + showTaskCmdExamples + utils.GetCmdFlags(taskCmdLiteral))
+		showTaskCmdLiteral, "[task-name]") + showTaskCmdExamples + getShowTaskCmdFlags())
 }
 
 func handleTaskCmdArguments(args []string) {
 	utils.Logln(utils.LogPrefixInfo + "Show task called")
-	if len(args) == 0 {
+	if taskNextFireTimes < 0 || (taskNextFireTimes > 0 && len(args) != 1) {
+		fmt.Println("--next requires a task name and a positive number of fire times. See the usage below")
+		printTaskHelp()
+	} else if len(args) == 0 {
 		executeListTasksCmd()
 	} else if len(args) == 1 {
 		if args[0] == "help" {
@@ -74,7 +86,12 @@ func handleTaskCmdArguments(args []string) {
 
 func printTaskHelp() {
 	fmt.Print(showTaskCmdLongDesc + utils.GetCmdUsage(programName, taskCmdLiteral, showTaskCmdLiteral,
-		"[task-name]") + showTaskCmdExamples + utils.GetCmdFlags(taskCmdLiteral))
+		"[task-name]") + showTaskCmdExamples + getShowTaskCmdFlags())
+}
+
+func getShowTaskCmdFlags() string {
+	return utils.GetListCmdFlagsWith(taskCmdLiteral, &artifactUtils.TaskList{},
+		"      --next\t\tPrint the given number of upcoming fire times of [task-name]\n"+taskTimeZoneFlags)
 }
 
 func executeGetTaskCmd(taskname string) {
@@ -87,6 +104,9 @@ func executeGetTaskCmd(taskname string) {
 		// Printing the details of the Task
 		task := resp.(*artifactUtils.Task)
 		printTask(*task)
+		if taskNextFireTimes > 0 {
+			printNextTaskFireTimes(*task)
+		}
 	} else {
 		fmt.Println(utils.LogPrefixError+"Getting Information of the Task", err)
 	}
@@ -115,9 +135,27 @@ func executeListTasksCmd() {
 	if err == nil {
 		// Printing the list of available Tasks
 		list := resp.(*artifactUtils.TaskList)
-		utils.PrintItemList(list, []string{utils.Name, utils.TriggerType, utils.Count, utils.Interval, utils.CronExpression},
-			"No Tasks found")
+		utils.PrintItemList(list, "No Tasks found")
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Tasks", err)
 	}
 }
+
+// Print the upcoming fire times of a task, computed locally from its trigger
+// @param task : Task object
+func printNextTaskFireTimes(task artifactUtils.Task) {
+	location, serverLocation := loadTaskTimeZones()
+	schedule, err := utils.NewTaskSchedule(task, serverLocation)
+	if err != nil {
+		utils.HandleErrorAndExit("Error computing the fire times of task "+task.Name+".", err)
+	}
+	fireTimes := schedule.FireTimes(time.Now().In(location), time.Time{}, taskNextFireTimes)
+	fmt.Println("Next " + strconv.Itoa(len(fireTimes)) + " Fire Times :")
+	for _, fireTime := range fireTimes {
+		fmt.Println("  " + formatTaskFireTime(fireTime, schedule.IsEstimated()))
+	}
+	if schedule.IsEstimated() {
+		fmt.Println("Fire times marked with ~ are estimated from now, as the first fire time of an interval " +
+			"trigger is not reported")
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+const triggerTaskCmdLiteral = "trigger"
+const triggerTaskCmdShortDesc = "Run a task immediately"
+
+const triggerTaskCmdLongDesc = "Run the scheduled task specified by [task-name] immediately, without changing its " +
+	"schedule\n"
+
+var triggerTaskCmdExamples = "Example:\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + triggerTaskCmdLiteral + " SampleTask\n\n"
+
+var triggerTaskCmdUsage = "Usage:\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + triggerTaskCmdLiteral + " [task-name]\n\n"
+
+var triggerTaskCmdHelpString = triggerTaskCmdLongDesc + triggerTaskCmdUsage + triggerTaskCmdExamples
+
+var taskTriggerCmd = &cobra.Command{
+	Use:   triggerTaskCmdLiteral,
+	Short: triggerTaskCmdShortDesc,
+	Long:  triggerTaskCmdLongDesc + triggerTaskCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleTriggerTaskCmdArguments(args)
+	},
+}
+
+func init() {
+	taskCmd.AddCommand(taskTriggerCmd)
+	taskTriggerCmd.SetHelpTemplate(triggerTaskCmdHelpString + utils.GetCmdFlags(triggerTaskCmdLiteral))
+}
+
+func handleTriggerTaskCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Trigger task called")
+	if len(args) == 0 {
+		fmt.Println("Please provide the name of the task to trigger. See the usage below")
+		printTriggerTaskHelp()
+	} else if len(args) > 1 {
+		fmt.Println("Too many arguments. See the usage below")
+		printTriggerTaskHelp()
+	} else if args[0] == utils.HelpCommand {
+		printTriggerTaskHelp()
+	} else {
+		executeTriggerTaskCmd(args[0])
+	}
+}
+
+func printTriggerTaskHelp() {
+	fmt.Print(triggerTaskCmdHelpString + utils.GetCmdFlags(triggerTaskCmdLiteral))
+}
+
+func executeTriggerTaskCmd(task string) {
+	resp, err := utils.UpdateMITask(task, utils.TaskActionTrigger)
+	if err != nil {
+		fmt.Println(utils.LogPrefixError+"Triggering task failed: ", err)
+		os.Exit(1)
+	}
+	fmt.Println(resp)
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+const updateTaskCmdLiteral = "update"
+const taskState = "state"
+const updateTaskCmdShortDesc = "Pause or resume a task"
+
+const updateTaskCmdLongDesc = "Pause and resume a specified scheduled task. The state is " + utils.TaskStatePause +
+	" or " + utils.TaskStateResume + "\n" +
+	"Several tasks can be named, or selected with --filter or --from-file, to update them concurrently\n" +
+	"Pausing asks for confirmation unless --yes is given\n"
+
+var updateTaskCmdUsage = "Usage:\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + updateTaskCmdLiteral + " [task-name]... " + taskState + " [state]\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + updateTaskCmdLiteral + " " + taskState + " [state] --filter [expression]\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + updateTaskCmdLiteral + " " + taskState + " [state] --from-file [file-path]\n\n"
+
+var updateTaskCmdExamples = "Example:\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + updateTaskCmdLiteral + " SampleTask state pause\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + updateTaskCmdLiteral + " SampleTask state resume\n" +
+	"To pause all the tasks without confirmation\n" +
+	"  " + programName + " " + taskCmdLiteral + " " + updateTaskCmdLiteral + " state pause --filter 'name~.' --yes\n\n"
+
+var updateTaskCmdHelpString = updateTaskCmdLongDesc + updateTaskCmdUsage + updateTaskCmdExamples
+
+var taskUpdateCmd = &cobra.Command{
+	Use:   updateTaskCmdLiteral,
+	Short: updateTaskCmdShortDesc,
+	Long:  updateTaskCmdLongDesc + updateTaskCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleUpdateTaskCmdArguments(args)
+	},
+}
+
+func init() {
+	taskCmd.AddCommand(taskUpdateCmd)
+	taskUpdateCmd.SetHelpTemplate(updateTaskCmdHelpString +
+		utils.GetStateUpdateCmdFlags(updateTaskCmdLiteral, &artifactUtils.TaskList{}))
+	addStateUpdateFlags(taskUpdateCmd)
+}
+
+func handleUpdateTaskCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Update task called")
+	if utils.ContainsString(args, "help") {
+		printUpdateTaskHelp()
+	} else if names, state, valid := parseStateUpdateArgs(args, taskState); !valid {
+		printInvalidTaskUpdateCmdMessage(args)
+	} else if state = strings.ToLower(state); state != utils.TaskStatePause && state != utils.TaskStateResume {
+		fmt.Println("Invalid task state '" + state + "'. The state should be " + utils.TaskStatePause + " or " +
+			utils.TaskStateResume)
+		printUpdateTaskHelp()
+	} else if isSingleStateUpdate(names) {
+		updateTaskState(names[0], state)
+	} else {
+		executeBulkStateUpdate(taskCmdLiteral, "task", names, state)
+	}
+}
+
+func printInvalidTaskUpdateCmdMessage(args []string) {
+	fmt.Println("task update:", args, "is not a valid command.\n"+
+		programName, "task update requires task names, --filter or --from-file followed by state [state]. "+
+		"See the usage below.")
+	printUpdateTaskHelp()
+}
+
+func printUpdateTaskHelp() {
+	fmt.Println(updateTaskCmdHelpString)
+}
+
+func updateTaskState(task string, intendedState string) {
+	if utils.IsStateReducing(intendedState) {
+		utils.ConfirmCurrentRemoteOperation("pause task "+task, confirmYes)
+	}
+	resp, err := utils.UpdateMITask(task, intendedState)
+	if err != nil {
+		fmt.Println(utils.LogPrefixError+"Updating state of task failed: ", err)
+		os.Exit(1)
+	}
+	fmt.Println(resp)
+}
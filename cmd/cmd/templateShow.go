@@ -58,9 +58,10 @@ var templateShowCmd = &cobra.Command{
 
 func init() {
 	templateCmd.AddCommand(templateShowCmd)
+	addListFlags(templateShowCmd)
 	templateShowCmd.SetHelpTemplate(showTemplateCmdLongDesc + utils.GetCmdUsage(programName, templateCmdLiteral,
 		utils.ShowCommand, "[template-type] [template-name]") + showTemplateCmdExamples +
-		utils.GetCmdFlags(templateCmdLiteral))
+		utils.GetListCmdFlags(templateCmdLiteral, &artifactUtils.TemplateList{}))
 }
 
 // template argument handling method
@@ -93,7 +94,7 @@ func handleTemplateCmdArguments(args []string) {
 
 func printTemplateHelp() {
 	fmt.Print(showTemplateCmdLongDesc + utils.GetCmdUsage(programName, templateCmdLiteral, utils.ShowCommand,
-		"[template-type] [template-name]") + showTemplateCmdExamples + utils.GetCmdFlags(templateCmdLiteral))
+		"[template-type] [template-name]") + showTemplateCmdExamples + utils.GetListCmdFlags(templateCmdLiteral, &artifactUtils.TemplateList{}))
 }
 
 func executeListTemplatesCmd() {
@@ -149,58 +150,11 @@ func executeGetTemplateByNameCmd(templateType string, templateName string) {
 }
 
 func printTemplateList(templateList artifactUtils.TemplateList) {
-	var isTemplateFound bool = false
-
-	allTemplateList := [][]string{}
-	if len(templateList.SequenceTemplates) > 0 {
-		isTemplateFound = true
-		for _, template := range templateList.SequenceTemplates {
-			data := []string{template.Name, "Sequence"}
-			allTemplateList = append(allTemplateList, data)
-		}
-	}
-
-	if len(templateList.EndpointTemplates) > 0 {
-		isTemplateFound = true
-		for _, template := range templateList.EndpointTemplates {
-			data := []string{template.Name, "Endpoint"}
-			allTemplateList = append(allTemplateList, data)
-		}
-	}
-
-	if len(allTemplateList) > 0 {
-		isTemplateFound = true
-		table := utils.GetTableWriter()
-
-		data := []string{utils.Name, utils.Type}
-		table.Append(data)
-
-		for _, template := range allTemplateList {
-			table.Append(template)
-		}
-		table.Render()
-	}
-
-	if !isTemplateFound {
-		fmt.Println("No Templates found")
-	}
+	utils.PrintItemList(&templateList, "No Templates found")
 }
 
 func printTemplatesByType(templateList artifactUtils.TemplateListByType) {
-	if templateList.Count > 0 {
-		table := utils.GetTableWriter()
-
-		data := []string{utils.Name}
-		table.Append(data)
-
-		for _, template := range templateList.Templates {
-			data = []string{template.Name}
-			table.Append(data)
-		}
-		table.Render()
-	} else {
-		fmt.Println("No Template found from the given type")
-	}
+	utils.PrintItemList(&templateList, "No Template found from the given type")
 }
 
 func printEndpointTemplatesByName(templateList artifactUtils.TemplateEndpointListByName) {
@@ -219,7 +173,7 @@ func printSequenceTemplatesByName(templateList artifactUtils.TemplateSequenceLis
 	if len(templateList.Parameters) > 0 {
 		table := utils.GetTableWriter()
 
-		data := []string{utils.Name, utils.DefaultValue, utils.IsMandatory}
+		data := []string{artifactUtils.ColumnName, artifactUtils.ColumnDefaultValue, artifactUtils.ColumnMandatory}
 		table.Append(data)
 
 		for _, param := range templateList.Parameters {
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+)
+
+// select the artifacts of the tracing and statistics commands in addition to the ones given as arguments
+var propertyUpdateFlows []string
+var propertyUpdateFromFile string
+
+// Tracing command related usage info
+const tracingCmdLiteral = "tracing"
+const tracingCmdShortDesc = "Enable or disable tracing of artifacts of several types"
+
+var tracingCmdHelpString = getArtifactsPropertyCmdHelp(tracingCmdLiteral)
+
+var tracingCmd = &cobra.Command{
+	Use:   tracingCmdLiteral,
+	Short: tracingCmdShortDesc,
+	Long:  tracingCmdHelpString,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleArtifactsPropertyCmdArguments(utils.ArtifactPropertyTracing, args)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(tracingCmd)
+	addArtifactsPropertyFlags(tracingCmd)
+	tracingCmd.SetHelpTemplate(tracingCmdHelpString)
+}
+
+// addArtifactsPropertyFlags adds the flags which select the artifacts of the tracing and statistics commands
+func addArtifactsPropertyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&propertyUpdateFlows, "flow", nil,
+		"Select the given [type]/[name] artifact and the artifacts it uses")
+	cmd.Flags().StringVar(&propertyUpdateFromFile, "from-file", "",
+		"File listing the [type]/[name] of the artifacts to update, one per line")
+}
+
+// help of the command enabling and disabling a property of artifacts of several types
+func getArtifactsPropertyCmdHelp(property string) string {
+	longDesc := "Enable or disable " + property + " of several artifacts of different types at once, such as the " +
+		"artifacts of a failing\nflow while debugging it. Artifacts are given as [type]/[name], where the type is " +
+		"one of\n" + strings.Join(utils.GetTraceableArtifactTypeNames(), ", ") + " or an alias such as proxy.\n" +
+		"With --flow, the given artifact and every artifact it uses directly or indirectly, as found in the\n" +
+		"Synapse configurations of the deployed artifacts, are selected\n"
+	usage := "Usage:\n" +
+		"  " + programName + " " + property + " [enable|disable] [type/name]...\n" +
+		"  " + programName + " " + property + " [enable|disable] --flow [type/name]\n" +
+		"  " + programName + " " + property + " [enable|disable] --from-file [file-path]\n\n"
+	examples := "Example:\n" +
+		"To enable " + property + " of an API and every sequence and endpoint it calls\n" +
+		"  " + programName + " " + property + " enable --flow api/OrderAPI\n\n" +
+		"To disable " + property + " of a proxy service and a sequence\n" +
+		"  " + programName + " " + property + " disable proxy/StockQuoteProxy sequence/LogSequence\n\n"
+	flags := "Flags:\n" +
+		"  -h, --help\t\tHelp for " + property + "\n" +
+		"      --flow\t\tSelect the given [type]/[name] artifact and the artifacts it uses. Can be repeated\n" +
+		"      --from-file\tFile listing the [type]/[name] of the artifacts to update, one per line\n" +
+		"Global Flags:\n" +
+		"  -v, --verbose\t\tEnable verbose mode\n" +
+		"      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+	return longDesc + usage + examples + flags
+}
+
+func handleArtifactsPropertyCmdArguments(property string, args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Update " + property + " called")
+	if len(args) == 1 && args[0] == utils.HelpCommand {
+		fmt.Print(getArtifactsPropertyCmdHelp(property))
+	} else if len(args) == 0 || (len(args) == 1 && len(propertyUpdateFlows) == 0 && propertyUpdateFromFile == "") {
+		fmt.Println(programName, property, "requires enable or disable followed by [type]/[name] artifacts, --flow "+
+			"or --from-file. See the usage below")
+		fmt.Print(getArtifactsPropertyCmdHelp(property))
+	} else {
+		executeArtifactsPropertyCmd(property, args[0], args[1:])
+	}
+}
+
+func executeArtifactsPropertyCmd(property, value string, references []string) {
+	if err := utils.CheckPropertyValue(property, value); err != nil {
+		utils.HandleErrorAndExit("Error updating the "+property+" of the artifacts.", err)
+	}
+	if propertyUpdateFromFile != "" {
+		fileReferences, err := utils.ReadNamesFile(propertyUpdateFromFile)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading the artifacts to update.", err)
+		}
+		references = append(references, fileReferences...)
+	}
+	var artifacts []utils.ArtifactReference
+	for _, reference := range references {
+		artifact, err := utils.ParseTraceableArtifactReference(reference)
+		if err != nil {
+			utils.HandleErrorAndExit("Invalid artifact.", err)
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	remote := utils.RemoteConfigData.Remotes[utils.RemoteConfigData.CurrentRemote]
+	artifacts = append(artifacts, selectFlowArtifacts(remote)...)
+
+	selected := make(map[string]utils.ArtifactReference)
+	var ids []string
+	for _, artifact := range artifacts {
+		if _, found := selected[artifact.String()]; !found {
+			selected[artifact.String()] = artifact
+			ids = append(ids, artifact.String())
+		}
+	}
+	results := utils.RunBulkUpdate(ids, func(id string) (string, error) {
+		artifact := selected[id]
+		return utils.UpdateArtifactProperty(remote, artifact.ArtifactType, artifact.Name, property, value)
+	})
+	printBulkUpdateResults(results, "artifact")
+}
+
+// select the artifacts of the flows given by --flow from the dependency graph of the deployed artifacts
+func selectFlowArtifacts(remote utils.Remote) []utils.ArtifactReference {
+	if len(propertyUpdateFlows) == 0 {
+		return nil
+	}
+	var starts []utils.ArtifactReference
+	for _, flow := range propertyUpdateFlows {
+		start, err := utils.ParseTraceableArtifactReference(flow)
+		if err != nil {
+			utils.HandleErrorAndExit("Invalid --flow.", err)
+		}
+		starts = append(starts, start)
+	}
+	configs, fileErrors, err := utils.FetchSynapseConfigs(remote)
+	if err != nil {
+		utils.HandleErrorAndExit("Error fetching the Synapse configurations of the flows.", err)
+	}
+	for _, fileError := range fileErrors {
+		fmt.Fprintln(os.Stderr, utils.LogPrefixWarning+"Skipping "+fileError.File+": "+fileError.Message)
+	}
+	graph := utils.BuildDependencyGraph(configs)
+
+	var artifacts []utils.ArtifactReference
+	for _, start := range starts {
+		flow, err := utils.SelectFlowArtifacts(graph, start)
+		if err != nil {
+			utils.HandleErrorAndExit("Invalid --flow.", err)
+		}
+		artifacts = append(artifacts, flow...)
+	}
+	return artifacts
+}
@@ -33,7 +33,9 @@ const removeUserCmdLongDesc = "Remove an existing user from the micro-integrator
 
 var removeUserCmdExamples = "Example:\n" +
     "To remove an existing user\n" +
-    "  " + programName + " " + usersCmdLiteral + " " + removeUserCmdLiteral + " [user-id]\n"
+    "  " + programName + " " + usersCmdLiteral + " " + removeUserCmdLiteral + " [user-id]\n" +
+    "To remove an existing user without confirmation\n" +
+    "  " + programName + " " + usersCmdLiteral + " " + removeUserCmdLiteral + " [user-id] --yes\n"
 
 // userRemoveCmd represents the remove users command
 var userRemoveCmd = &cobra.Command{
@@ -48,7 +50,8 @@ var userRemoveCmd = &cobra.Command{
 func init() {
     userRemoveCmd.SetHelpTemplate(removeUserCmdLongDesc +  "Usage:\n" +
         "  " + programName + " " + usersCmdLiteral + " " + removeUserCmdLiteral + " [user-id]\n" +
-        removeUserCmdExamples + utils.GetCmdFlags(removeUserCmdLiteral))
+        removeUserCmdExamples + utils.GetConfirmCmdFlags(removeUserCmdLiteral))
+    addConfirmFlag(userRemoveCmd)
     usersCmd.AddCommand(userRemoveCmd)
 }
 
@@ -73,11 +76,12 @@ func handleRemoveUserCmdArguments(args []string) {
 func printRemoveUserHelp() {
     fmt.Print(removeUserCmdLongDesc + "Usage:\n" +
         "  " + programName + " " + usersCmdLiteral + " " + removeUserCmdLiteral + " [user-id]\n" +
-        removeUserCmdExamples + utils.GetCmdFlags(usersCmdLiteral))
+        removeUserCmdExamples + utils.GetConfirmCmdFlags(removeUserCmdLiteral))
 }
 
 func executeRemoveUserCmd(userId string) {
 
+    utils.ConfirmCurrentRemoteOperation("remove user " + userId, confirmYes)
     finalUrl := utils.GetRESTAPIBase() + utils.PrefixUsers + "/" + userId
     res, err := utils.InvokeDELETERequest(finalUrl, nil)
     var errString = "Error occurred while removing the user"
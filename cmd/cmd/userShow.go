@@ -64,8 +64,9 @@ func init() {
     userShowCmd.Flags().StringP("pattern", "p", "", "Filter users by regex")
     userShowCmd.SetHelpTemplate(showUserCmdLongDesc + utils.GetCmdUsageMultipleArgs(programName, usersCmdLiteral,
         showUserCmdLiteral, []string {"[user-id]", "--role=[role-name]", "--pattern=[username regex]"}) +
-        showUsersCmdExamples + utils.GetCmdFlags(showUserCmdLiteral))
+        showUsersCmdExamples + utils.GetListCmdFlags(showUserCmdLiteral, &artifactUtils.UserList{}))
     usersCmd.AddCommand(userShowCmd)
+    addListFlags(userShowCmd)
 }
 
 func handleUsersCmdArguments(args []string, userRole string, userPattern string) {
@@ -97,7 +98,7 @@ func handleUsersCmdArguments(args []string, userRole string, userPattern string)
 func printUsersHelp() {
     fmt.Print(showUserCmdLongDesc + utils.GetCmdUsageMultipleArgs(programName, usersCmdLiteral,
         showUserCmdLiteral, []string {"[user-id]", "--role=[role-name]", "--pattern=[username regex]"}) +
-        showUsersCmdExamples + utils.GetCmdFlags(usersCmdLiteral))
+        showUsersCmdExamples + utils.GetListCmdFlags(usersCmdLiteral, &artifactUtils.UserList{}))
 }
 
 func executeGetUserCmd(userId string, userRole string, userPattern string) {
@@ -125,7 +126,7 @@ func executeGetUserCmd(userId string, userRole string, userPattern string) {
         if err == nil {
             // Printing the list of available users
             list := resp.(*artifactUtils.UserList)
-            utils.PrintItemList(list, []string{utils.UserId}, "No users found")
+            utils.PrintItemList(list, "No users found")
         } else {
             utils.Logln(utils.LogPrefixError+"Getting List of users with role: " +
                 userRole + " and user-id pattern: " + userPattern, err)
@@ -152,7 +153,7 @@ func executeListUsersCmd() {
     if err == nil {
         // Printing the list of available Users
         list := resp.(*artifactUtils.UserList)
-        utils.PrintItemList(list, []string{utils.UserId}, "No Users found")
+        utils.PrintItemList(list, "No Users found")
     } else {
         utils.Logln(utils.LogPrefixError + "Getting List of Users", err)
     }
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-mi-tooling/cmd/utils"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+var waitTimeout time.Duration
+var waitInterval time.Duration
+
+// list fetched in a single poll, shared by the conditions on the same artifact type
+type artifactList struct {
+	table artifactUtils.Table
+	err   error
+}
+
+// Wait command related usage info
+const waitCmdLiteral = "wait"
+const waitCmdShortDesc = "Wait until artifacts reach a given state"
+
+var waitCmdLongDesc = "Block until all the given conditions hold at the same time on the current Micro " +
+	"Integrator or the\ntimeout elapses.\nA condition is an artifact given as [type]/[name] followed by one of\n" +
+	"  exists, absent, active, inactive or a filter expression such as size=0\n" +
+	"Supported types: " + strings.Join(utils.GetArtifactTypeNames(), ", ") + "\n\n"
+
+var waitCmdUsage = "Usage:\n" +
+	"  " + programName + " " + waitCmdLiteral + " [type]/[name] [state] ... --timeout=[duration] --interval=[duration]\n\n"
+
+var waitCmdExamples = "Example:\n" +
+	"To wait until a composite app is deployed and active\n" +
+	"  " + programName + " " + waitCmdLiteral + " compositeapp/OrderApp active --timeout=2m\n\n" +
+	"To wait until an API is listed and an endpoint is active again\n" +
+	"  " + programName + " " + waitCmdLiteral + " api/OrderAPI exists endpoint/OrderEP active\n\n" +
+	"To wait until a message store is drained\n" +
+	"  " + programName + " " + waitCmdLiteral + " messagestore/OrderStore size=0 --interval=10s\n\n"
+
+var waitCmdFlags = "Flags:\n" +
+	"  -h, --help\t\tHelp for " + waitCmdLiteral + "\n" +
+	"      --timeout\t\tMaximum time to wait (default 5m0s)\n" +
+	"      --interval\tTime between two polls (default 5s)\n" +
+	"Global Flags:\n" +
+	"  -v, --verbose\t\tEnable verbose mode\n" +
+	"      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+
+var waitCmdHelpString = waitCmdLongDesc + waitCmdUsage + waitCmdExamples + waitCmdFlags
+
+// waitCmd represents the wait command
+var waitCmd = &cobra.Command{
+	Use:   waitCmdLiteral,
+	Short: waitCmdShortDesc,
+	Long:  waitCmdLongDesc + waitCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleWaitCmdArguments(args)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(waitCmd)
+	waitCmd.Flags().DurationVar(&waitTimeout, "timeout", 5*time.Minute, "Maximum time to wait")
+	waitCmd.Flags().DurationVar(&waitInterval, "interval", 5*time.Second, "Time between two polls")
+	waitCmd.SetHelpTemplate(waitCmdHelpString)
+}
+
+func handleWaitCmdArguments(args []string) {
+	utils.Logln(utils.LogPrefixInfo + "Wait called")
+	if len(args) == 1 && args[0] == utils.HelpCommand {
+		printWaitHelp()
+		return
+	}
+	if len(args) == 0 || len(args)%2 != 0 {
+		fmt.Println("Each artifact must be followed by a state. See the usage below")
+		printWaitHelp()
+		return
+	}
+	var conditions []utils.WaitCondition
+	for i := 0; i < len(args); i += 2 {
+		condition, err := utils.ParseWaitCondition(args[i], args[i+1])
+		if err != nil {
+			utils.HandleErrorAndExit("Invalid condition.", err)
+		}
+		conditions = append(conditions, condition)
+	}
+	executeWaitCmd(conditions)
+}
+
+func printWaitHelp() {
+	fmt.Print(waitCmdHelpString)
+}
+
+func executeWaitCmd(conditions []utils.WaitCondition) {
+	if waitInterval <= 0 {
+		utils.HandleErrorAndExit("Invalid interval.", errors.New("interval must be positive"))
+	}
+	remote := utils.RemoteConfigData.Remotes[utils.RemoteConfigData.CurrentRemote]
+	deadline := time.Now().Add(waitTimeout)
+	met := make([]bool, len(conditions))
+	var lastErr error
+
+	// every condition is checked in each poll, so that they all hold at the same time
+	for {
+		lastErr = nil
+		lists := make(map[string]artifactList)
+		pending := 0
+		for i, condition := range conditions {
+			list, found := lists[condition.ArtifactType.Name]
+			if !found {
+				list.table, list.err = utils.FetchArtifactList(remote, condition.ArtifactType)
+				lists[condition.ArtifactType.Name] = list
+			}
+			satisfied := false
+			if list.err != nil {
+				utils.Logln(utils.LogPrefixWarning+"Fetching "+condition.ArtifactType.Name+" list failed:", list.err)
+				lastErr = list.err
+			} else {
+				satisfied = condition.IsSatisfiedBy(list.table)
+			}
+			if satisfied && !met[i] {
+				fmt.Println("Condition met: " + condition.String())
+			} else if !satisfied && met[i] {
+				fmt.Println("Condition no longer met: " + condition.String())
+			}
+			met[i] = satisfied
+			if !satisfied {
+				pending++
+			}
+		}
+		if pending == 0 {
+			return
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+		// the last poll is made at the deadline
+		if remaining < waitInterval {
+			time.Sleep(remaining)
+		} else {
+			time.Sleep(waitInterval)
+		}
+	}
+
+	for i, condition := range conditions {
+		if !met[i] {
+			fmt.Println("Condition not met: " + condition.String())
+		}
+	}
+	utils.HandleErrorAndExit("Timed out after "+waitTimeout.String()+" waiting for the conditions.", lastErr)
+}
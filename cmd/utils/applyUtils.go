@@ -0,0 +1,312 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+	"gopkg.in/yaml.v2"
+)
+
+const DesiredStateActive = "active"
+const DesiredStateInactive = "inactive"
+const DesiredStatePresent = "present"
+const DesiredStateAbsent = "absent"
+
+// DesiredStateSectionUser is the section of the changes made to the users of a Micro Integrator
+const DesiredStateSectionUser = "user"
+
+// sections of the changes made to the states of artifacts, named after their artifact types
+const desiredStateSectionEndpoint = "endpoint"
+const desiredStateSectionProxyService = "proxyservice"
+const desiredStateSectionMessageProcessor = "messageprocessor"
+
+var logLevels = []string{"OFF", "TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+
+// DesiredState is the state of the runtime settings of a Micro Integrator declared in a state file
+type DesiredState struct {
+	Endpoints         map[string]string      `yaml:"endpoints"`
+	ProxyServices     map[string]string      `yaml:"proxyServices"`
+	MessageProcessors map[string]string      `yaml:"messageProcessors"`
+	Loggers           map[string]LoggerState `yaml:"loggers"`
+	Users             map[string]UserState   `yaml:"users"`
+}
+
+// LoggerState is the level of a logger, with the class needed to add a logger which does not exist yet
+type LoggerState struct {
+	Level string `yaml:"level"`
+	Class string `yaml:"class,omitempty"`
+}
+
+// UserState declares whether a user exists. Passwords are read from environment variables so that state files
+// can be kept in source control
+type UserState struct {
+	State       string `yaml:"state"`
+	Admin       bool   `yaml:"admin"`
+	PasswordEnv string `yaml:"passwordEnv"`
+}
+
+// UnmarshalYAML accepts a logger given by its level alone, such as root: INFO
+func (logger *LoggerState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var level string
+	if unmarshal(&level) == nil {
+		logger.Level = level
+		return nil
+	}
+	type plainLoggerState LoggerState
+	return unmarshal((*plainLoggerState)(logger))
+}
+
+// Read and validate a state file
+// @param path : path of the YAML file
+// @return desired state
+// @return error if the file cannot be read, has unknown keys or holds invalid states or levels
+func ReadDesiredState(path string) (DesiredState, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return DesiredState{}, err
+	}
+	var state DesiredState
+	if err = yaml.UnmarshalStrict(data, &state); err != nil {
+		return DesiredState{}, errors.New(path + " is not a valid state file: " + err.Error())
+	}
+	var problems []string
+	for section, states := range map[string]map[string]string{"endpoints": state.Endpoints,
+		"proxyServices": state.ProxyServices, "messageProcessors": state.MessageProcessors} {
+		for name, value := range states {
+			if value != DesiredStateActive && value != DesiredStateInactive {
+				problems = append(problems, section+"."+name+" must be "+DesiredStateActive+" or "+
+					DesiredStateInactive)
+			}
+		}
+	}
+	for name, logger := range state.Loggers {
+		if !ContainsString(logLevels, strings.ToUpper(logger.Level)) {
+			problems = append(problems, "loggers."+name+" must be one of "+strings.Join(logLevels, ", "))
+		}
+	}
+	for name, user := range state.Users {
+		if user.State != "" && user.State != DesiredStatePresent && user.State != DesiredStateAbsent {
+			problems = append(problems, "users."+name+".state must be "+DesiredStatePresent+" or "+
+				DesiredStateAbsent)
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return DesiredState{}, errors.New(path + " is not a valid state file: " + strings.Join(problems, "; "))
+	}
+	return state, nil
+}
+
+// Compare a desired state with a remote and find the changes needed to reach it
+// @param remote : Micro Integrator to compare with
+// @param state : desired state
+// @return changes ordered by section and name
+// @return errors for the settings which cannot be reached, such as states of artifacts which are not deployed
+func PlanDesiredState(remote Remote, state DesiredState) ([]InventoryChange, []error) {
+	var changes []InventoryChange
+	var errs []error
+
+	if len(state.Endpoints) > 0 {
+		list := &artifactUtils.EndpointList{}
+		if err := FetchRemoteData(remote, PrefixEndpoints, nil, list); err != nil {
+			errs = append(errs, errors.New("fetching endpoint list failed: "+err.Error()))
+		} else {
+			current := make(map[string]string)
+			for _, endpoint := range list.Endpoints {
+				current[endpoint.Name] = getActiveState(endpoint.Active)
+			}
+			planStates(desiredStateSectionEndpoint, state.Endpoints, current, &changes, &errs)
+		}
+	}
+
+	if len(state.ProxyServices) > 0 {
+		current := make(map[string]string)
+		for name := range state.ProxyServices {
+			proxy := &artifactUtils.Proxy{}
+			err := FetchRemoteData(remote, PrefixProxyServices, map[string]string{"proxyServiceName": name}, proxy)
+			if err != nil {
+				errs = append(errs, errors.New("fetching proxy service "+name+" failed: "+err.Error()))
+			} else if proxy.IsRunning == nil {
+				// the change is always made when the server does not report the current state
+				current[name] = "unknown"
+			} else {
+				current[name] = getActiveState(*proxy.IsRunning)
+			}
+		}
+		planStates(desiredStateSectionProxyService, state.ProxyServices, current, &changes, nil)
+	}
+
+	if len(state.MessageProcessors) > 0 {
+		list := &artifactUtils.MessageProcessorList{}
+		if err := FetchRemoteData(remote, PrefixMessageProcessors, nil, list); err != nil {
+			errs = append(errs, errors.New("fetching messageprocessor list failed: "+err.Error()))
+		} else {
+			current := make(map[string]string)
+			for _, processor := range list.MessageProcessors {
+				current[processor.Name] = processor.Status
+			}
+			planStates(desiredStateSectionMessageProcessor, state.MessageProcessors, current, &changes, &errs)
+		}
+	}
+
+	if len(state.Loggers) > 0 {
+		loggers := &LoggerList{}
+		if err := FetchRemoteData(remote, PrefixLogging, nil, loggers); err != nil {
+			errs = append(errs, errors.New("fetching log levels failed: "+err.Error()))
+		} else {
+			current := make(map[string]string)
+			for _, logger := range loggers.Loggers {
+				current[logger.LoggerName] = logger.LogLevel
+			}
+			for _, name := range getSortedLoggerNames(state.Loggers) {
+				logger := state.Loggers[name]
+				level := strings.ToUpper(logger.Level)
+				currentLevel, found := current[name]
+				if !found && logger.Class == "" {
+					errs = append(errs, errors.New("logger "+name+" does not exist, give its class to add it"))
+				} else if !found {
+					changes = append(changes, InventoryChange{Kind: InventoryChangeAdded,
+						Section: InventorySectionLogLevel, Name: name, Property: "level", New: level})
+				} else if !strings.EqualFold(currentLevel, level) {
+					changes = append(changes, InventoryChange{Kind: InventoryChangeChanged,
+						Section: InventorySectionLogLevel, Name: name, Property: "level", Old: currentLevel,
+						New: level})
+				}
+			}
+		}
+	}
+
+	if len(state.Users) > 0 {
+		users := &artifactUtils.UserList{}
+		if err := FetchRemoteData(remote, PrefixUsers, nil, users); err != nil {
+			errs = append(errs, errors.New("fetching user list failed: "+err.Error()))
+		} else {
+			var current []string
+			for _, user := range users.Users {
+				current = append(current, user.UserId)
+			}
+			for _, name := range getSortedUserNames(state.Users) {
+				user := state.Users[name]
+				exists := ContainsString(current, name)
+				if user.State == DesiredStateAbsent && exists {
+					changes = append(changes, InventoryChange{Kind: InventoryChangeRemoved,
+						Section: DesiredStateSectionUser, Name: name})
+				} else if user.State != DesiredStateAbsent && !exists {
+					if user.PasswordEnv == "" || os.Getenv(user.PasswordEnv) == "" {
+						errs = append(errs, errors.New("user "+name+" does not exist, set passwordEnv to an "+
+							"environment variable holding its password to add it"))
+						continue
+					}
+					changes = append(changes, InventoryChange{Kind: InventoryChangeAdded,
+						Section: DesiredStateSectionUser, Name: name, Property: "admin",
+						New: strconv.FormatBool(user.Admin)})
+				}
+			}
+		}
+	}
+	return changes, errs
+}
+
+// add a change for each artifact whose current state differs from the desired one, and an error for each
+// artifact which does not exist if errs is given
+func planStates(section string, desired, current map[string]string, changes *[]InventoryChange, errs *[]error) {
+	for _, name := range getSortedKeys(desired, nil) {
+		currentState, found := current[name]
+		if !found {
+			if errs != nil {
+				*errs = append(*errs, errors.New(section+" "+name+" is not deployed"))
+			}
+			continue
+		}
+		if currentState != desired[name] {
+			*changes = append(*changes, InventoryChange{Kind: InventoryChangeChanged, Section: section, Name: name,
+				Property: "state", Old: currentState, New: desired[name]})
+		}
+	}
+}
+
+// IsReducingChange reports whether a planned change removes something or stops an artifact from serving
+func IsReducingChange(change InventoryChange) bool {
+	return change.Kind == InventoryChangeRemoved || (change.Property == "state" && IsStateReducing(change.New))
+}
+
+func getActiveState(active bool) string {
+	if active {
+		return DesiredStateActive
+	}
+	return DesiredStateInactive
+}
+
+func getSortedLoggerNames(loggers map[string]LoggerState) []string {
+	var names []string
+	for name := range loggers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func getSortedUserNames(users map[string]UserState) []string {
+	var names []string
+	for name := range users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Make a change planned for the current remote through the update helpers of each section. Failures, including
+// connection failures, are returned so that the changes made before are still reported
+// @param change : change from the plan
+// @param state : desired state the change was planned from
+// @return error if the server rejects the change or cannot be reached
+func ApplyDesiredStateChange(change InventoryChange, state DesiredState) error {
+	remote := RemoteConfigData.Remotes[RemoteConfigData.CurrentRemote]
+	var err error
+	switch change.Section {
+	case desiredStateSectionEndpoint, desiredStateSectionProxyService, desiredStateSectionMessageProcessor:
+		// the sections are named after the artifact types
+		artifactType, _ := GetArtifactType(change.Section)
+		_, err = UpdateArtifactState(remote, artifactType, change.Name, change.New)
+	case InventorySectionLogLevel:
+		// like the loggers added by a debug session, the class is only given to add a logger
+		class := ""
+		if change.Kind == InventoryChangeAdded {
+			class = state.Loggers[change.Name].Class
+		}
+		_, err = UpdateRemoteLogger(remote, change.Name, change.New, class)
+	case DesiredStateSectionUser:
+		if change.Kind == InventoryChangeRemoved {
+			err = RemoveMIUser(change.Name)
+		} else {
+			user := state.Users[change.Name]
+			err = AddMIUser(change.Name, os.Getenv(user.PasswordEnv), user.Admin)
+		}
+	default:
+		err = errors.New("unknown section " + change.Section)
+	}
+	return err
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+func writeStateFile(t *testing.T, content string) string {
+	file, err := ioutil.TempFile("", "state*.yaml")
+	if err != nil {
+		t.Fatal("Error creating the state file: ", err)
+	}
+	defer file.Close()
+	if _, err = file.WriteString(content); err != nil {
+		t.Fatal("Error writing the state file: ", err)
+	}
+	return file.Name()
+}
+
+func TestReadDesiredState(t *testing.T) {
+	path := writeStateFile(t, "endpoints:\n  StockEP: inactive\nloggers:\n  root: INFO\n"+
+		"  com-example:\n    level: DEBUG\n    class: com.example\nusers:\n  guest:\n    state: absent\n")
+	defer os.Remove(path)
+	state, err := ReadDesiredState(path)
+	if err != nil {
+		t.Fatal("Error reading the state file: ", err)
+	}
+	AssertEqual(t, "inactive", state.Endpoints["StockEP"])
+	AssertEqual(t, "INFO", state.Loggers["root"].Level)
+	AssertEqual(t, "com.example", state.Loggers["com-example"].Class)
+	AssertEqual(t, DesiredStateAbsent, state.Users["guest"].State)
+
+	for _, content := range []string{"endpoints:\n  StockEP: stopped\n", "loggers:\n  root: LOUD\n",
+		"users:\n  guest:\n    state: gone\n", "endpoint:\n  StockEP: active\n"} {
+		path := writeStateFile(t, content)
+		if _, err = ReadDesiredState(path); err == nil {
+			t.Error("Expected an error reading the state file " + content)
+		}
+		os.Remove(path)
+	}
+}
+
+func TestPlanDesiredState(t *testing.T) {
+	server, remote := createRemoteServer(t, map[string]string{
+		PrefixEndpoints: `{"count": 2, "list": [{"name": "StockEP", "isActive": true}, ` +
+			`{"name": "PayEP", "isActive": false}]}`,
+		PrefixProxyServices + "?proxyServiceName=StockQuoteProxy": `{"name": "StockQuoteProxy", "isRunning": false}`,
+		PrefixLogging: `{"count": 1, "list": [{"loggerName": "root", "level": "INFO"}]}`,
+		PrefixUsers:   `{"count": 2, "list": [{"userId": "admin"}, {"userId": "guest"}]}`,
+	})
+	defer server.Close()
+	os.Setenv("MI_TEST_OPS_PASSWORD", "secret")
+	defer os.Unsetenv("MI_TEST_OPS_PASSWORD")
+
+	state := DesiredState{
+		Endpoints:     map[string]string{"StockEP": "inactive", "PayEP": "inactive"},
+		ProxyServices: map[string]string{"StockQuoteProxy": "inactive"},
+		Loggers:       map[string]LoggerState{"root": {Level: "debug"}},
+		Users: map[string]UserState{"guest": {State: DesiredStateAbsent},
+			"ops": {PasswordEnv: "MI_TEST_OPS_PASSWORD"}, "admin": {}},
+	}
+	changes, errs := PlanDesiredState(remote, state)
+	AssertEqual(t, 0, len(errs))
+	var plan []string
+	for _, change := range changes {
+		plan = append(plan, change.String())
+	}
+	AssertEqual(t, 4, len(plan))
+	AssertEqual(t, "~ endpoint/StockEP state: active -> inactive", plan[0])
+	AssertEqual(t, "~ log-level/root level: INFO -> DEBUG", plan[1])
+	AssertEqual(t, "- user/guest", plan[2])
+	AssertEqual(t, "+ user/ops", plan[3])
+
+	var reducing []bool
+	for _, change := range changes {
+		reducing = append(reducing, IsReducingChange(change))
+	}
+	AssertEqual(t, "[true false true false]", fmt.Sprint(reducing))
+
+	state = DesiredState{Endpoints: map[string]string{"OrderEP": "active"},
+		Loggers: map[string]LoggerState{"com-example": {Level: "DEBUG"}}}
+	_, errs = PlanDesiredState(remote, state)
+	AssertEqual(t, 2, len(errs))
+}
+
+func TestApplyDesiredStateChangeLoggerClass(t *testing.T) {
+	var requests []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		_, hasClass := body["loggerClass"]
+		requests = append(requests, body["loggerName"]+" "+body["loggingLevel"]+" "+fmt.Sprint(hasClass))
+		w.Header().Set(HeaderContentType, HeaderValueApplicationJSON)
+		w.Write([]byte(`{"message": "Updated"}`))
+	}))
+	defer server.Close()
+	serverUrl, _ := url.Parse(server.URL)
+	defer func(config RemoteConfig) { RemoteConfigData = config }(RemoteConfigData)
+	RemoteConfigData = RemoteConfig{CurrentRemote: "node1", Remotes: Remotes{
+		"node1": {Url: serverUrl.Hostname(), Port: serverUrl.Port(), AccessToken: "token"}}}
+
+	state := DesiredState{Loggers: map[string]LoggerState{
+		"com-example": {Level: "DEBUG", Class: "com.example"},
+		"org-apache":  {Level: "WARN", Class: "org.apache"}}}
+	err := ApplyDesiredStateChange(InventoryChange{Kind: InventoryChangeAdded, Section: InventorySectionLogLevel,
+		Name: "com-example", Property: "level", New: "DEBUG"}, state)
+	if err != nil {
+		t.Fatal("Error adding the logger: ", err)
+	}
+	err = ApplyDesiredStateChange(InventoryChange{Kind: InventoryChangeChanged, Section: InventorySectionLogLevel,
+		Name: "org-apache", Property: "level", Old: "INFO", New: "WARN"}, state)
+	if err != nil {
+		t.Fatal("Error changing the logger: ", err)
+	}
+	AssertEqual(t, "com-example DEBUG true\norg-apache WARN false", strings.Join(requests, "\n"))
+}
@@ -0,0 +1,299 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+// ArtifactType describes an artifact type which can be listed through the management API
+type ArtifactType struct {
+	// Name is the command literal of the artifact type
+	Name string
+	// Aliases are alternative names accepted on the command line
+	Aliases []string
+	// Resource is the management API resource listing the artifacts
+	Resource string
+	// NewList creates an empty list to unmarshal the response into
+	NewList func() artifactUtils.Table
+	// ActiveCondition is a filter expression which holds for active artifacts, empty if the type has no state
+	ActiveCondition string
+	// ProblemCondition is a filter expression which holds for artifacts needing attention
+	ProblemCondition string
+	// Problem describes why an artifact satisfying the problem condition needs attention
+	Problem func(row artifactUtils.Row) string
+	// DetailParam is the query parameter selecting a single artifact, empty if the summary is complete
+	DetailParam string
+	// NewDetail creates an empty detailed view to unmarshal a single artifact into
+	NewDetail func() artifactUtils.Detail
+	// CarTypes are the names of the type in Composite App metadata, such as proxy-service
+	CarTypes []string
+	// ConfigParams are the query parameters selecting an artifact of a row of the list together with its Synapse
+	// configuration, nil if the configuration of the type is not available through the management API
+	ConfigParams func(row artifactUtils.Row) map[string]string
+	// Traceable is true if statistics and tracing of the artifacts can be enabled and disabled
+	Traceable bool
+}
+
+// ArtifactListResult holds the list of artifacts of a type fetched from a remote
+type ArtifactListResult struct {
+	ArtifactType ArtifactType
+	List         artifactUtils.Table
+	Err          error
+}
+
+var ArtifactTypes = []ArtifactType{
+	{Name: "compositeapp", Aliases: []string{"capp", "carbonapp"}, Resource: PrefixCarbonApps,
+		NewList:         func() artifactUtils.Table { return &artifactUtils.CompositeAppList{} },
+		ActiveCondition: "status=active", ProblemCondition: "status=faulty",
+		Problem: func(row artifactUtils.Row) string { return "Faulty" }},
+	{Name: "api", Resource: PrefixAPIs,
+		NewList:     func() artifactUtils.Table { return &artifactUtils.APIList{} },
+		DetailParam: "apiName", NewDetail: func() artifactUtils.Detail { return &artifactUtils.API{} },
+		CarTypes:     []string{"api"},
+		ConfigParams: nameParam("apiName"), Traceable: true},
+	{Name: "proxyservice", Aliases: []string{"proxy"}, Resource: PrefixProxyServices,
+		NewList:     func() artifactUtils.Table { return &artifactUtils.ProxyServiceList{} },
+		DetailParam: "proxyServiceName", NewDetail: func() artifactUtils.Detail { return &artifactUtils.Proxy{} },
+		CarTypes:     []string{"proxy-service"},
+		ConfigParams: nameParam("proxyServiceName"), Traceable: true},
+	{Name: "endpoint", Resource: PrefixEndpoints,
+		NewList:         func() artifactUtils.Table { return &artifactUtils.EndpointList{} },
+		ActiveCondition: "active=true", ProblemCondition: "active=false",
+		Problem:     func(row artifactUtils.Row) string { return "Inactive" },
+		DetailParam: "endpointName", NewDetail: func() artifactUtils.Detail { return &artifactUtils.Endpoint{} },
+		CarTypes:     []string{"endpoint"},
+		ConfigParams: nameParam("endpointName"), Traceable: true},
+	{Name: "inboundendpoint", Aliases: []string{"inbound"}, Resource: PrefixInboundEndpoints,
+		NewList:      func() artifactUtils.Table { return &artifactUtils.InboundEndpointList{} },
+		DetailParam:  "inboundEndpointName",
+		NewDetail:    func() artifactUtils.Detail { return &artifactUtils.InboundEndpoint{} },
+		CarTypes:     []string{"inbound-endpoint"},
+		ConfigParams: nameParam("inboundEndpointName"), Traceable: true},
+	{Name: "sequence", Resource: PrefixSequences,
+		NewList:      func() artifactUtils.Table { return &artifactUtils.SequenceList{} },
+		CarTypes:     []string{"sequence"},
+		ConfigParams: nameParam("sequenceName"), Traceable: true},
+	{Name: "task", Resource: PrefixTasks,
+		NewList:      func() artifactUtils.Table { return &artifactUtils.TaskList{} },
+		CarTypes:     []string{"task"},
+		ConfigParams: nameParam("taskName")},
+	{Name: "template", Resource: PrefixTemplates,
+		NewList:  func() artifactUtils.Table { return &artifactUtils.TemplateList{} },
+		CarTypes: []string{"template", "sequenceTemplate", "endpointTemplate"},
+		ConfigParams: func(row artifactUtils.Row) map[string]string {
+			return map[string]string{"type": strings.ToLower(cellAt(row, 1)), "name": cellAt(row, 0)}
+		}},
+	{Name: "connector", Resource: PrefixConnectors,
+		NewList:         func() artifactUtils.Table { return &artifactUtils.ConnectorList{} },
+		ActiveCondition: "status=enabled", ProblemCondition: "status!=enabled",
+		Problem: func(row artifactUtils.Row) string { return "Disabled" }},
+	{Name: "localentry", Resource: PrefixLocalEntries,
+		NewList:      func() artifactUtils.Table { return &artifactUtils.LocalEntryList{} },
+		CarTypes:     []string{"local-entry"},
+		ConfigParams: nameParam("name")},
+	{Name: "messagestore", Resource: PrefixMessageStores,
+		NewList:          func() artifactUtils.Table { return &artifactUtils.MessageStoreList{} },
+		ProblemCondition: "size>0",
+		Problem:          func(row artifactUtils.Row) string { return "Not empty, " + cellAt(row, 2) + " messages" },
+		CarTypes:         []string{"message-store"},
+		ConfigParams:     nameParam("name")},
+	{Name: "messageprocessor", Resource: PrefixMessageProcessors,
+		NewList:         func() artifactUtils.Table { return &artifactUtils.MessageProcessorList{} },
+		ActiveCondition: "status=active", ProblemCondition: "status!=active",
+		Problem:      func(row artifactUtils.Row) string { return "Deactivated" },
+		CarTypes:     []string{"message-processors"},
+		ConfigParams: nameParam("name")},
+	{Name: "dataservice", Resource: PrefixDataServices,
+		NewList:  func() artifactUtils.Table { return &artifactUtils.DataServicesList{} },
+		CarTypes: []string{"dataservice"}},
+}
+
+// selects the artifact of a row by passing its name in the given query parameter
+func nameParam(param string) func(row artifactUtils.Row) map[string]string {
+	return func(row artifactUtils.Row) map[string]string {
+		return map[string]string{param: cellAt(row, 0)}
+	}
+}
+
+// ArtifactConfigurationResult holds the Synapse configuration of an artifact fetched from a remote
+type ArtifactConfigurationResult struct {
+	ArtifactType  ArtifactType
+	Name          string
+	Configuration string
+	Err           error
+}
+
+// GetArtifactType finds an artifact type by its name or one of its aliases
+func GetArtifactType(name string) (ArtifactType, error) {
+	for _, artifactType := range ArtifactTypes {
+		if strings.EqualFold(artifactType.Name, name) || ContainsString(artifactType.Aliases, name) {
+			return artifactType, nil
+		}
+	}
+	return ArtifactType{}, errors.New("unknown artifact type '" + name + "'. Supported types: " +
+		strings.Join(GetArtifactTypeNames(), ", "))
+}
+
+// GetArtifactTypeOfCarType finds the artifact type of an artifact type name used in Composite App metadata
+func GetArtifactTypeOfCarType(carType string) (ArtifactType, bool) {
+	for _, artifactType := range ArtifactTypes {
+		if ContainsString(artifactType.CarTypes, carType) {
+			return artifactType, true
+		}
+	}
+	return ArtifactType{}, false
+}
+
+// GetArtifactTypeNames returns the names of all the artifact types
+func GetArtifactTypeNames() []string {
+	var names []string
+	for _, artifactType := range ArtifactTypes {
+		names = append(names, artifactType.Name)
+	}
+	return names
+}
+
+// Fetch the list of artifacts of the given type from a remote
+// @param remote : Micro Integrator to fetch the list from
+// @param artifactType : type of the artifacts
+// @return list of artifacts
+// @return error if the list could not be fetched
+func FetchArtifactList(remote Remote, artifactType ArtifactType) (artifactUtils.Table, error) {
+	list := artifactType.NewList()
+	err := FetchRemoteData(remote, artifactType.Resource, nil, list)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Fetch the lists of the given artifact types from a remote concurrently
+// @param remote : Micro Integrator to fetch the lists from
+// @param artifactTypes : types of the artifacts
+// @return a result for each type, in the order of the given types
+func FetchArtifactLists(remote Remote, artifactTypes []ArtifactType) []ArtifactListResult {
+	results := make([]ArtifactListResult, len(artifactTypes))
+	var waitGroup sync.WaitGroup
+	for i, artifactType := range artifactTypes {
+		waitGroup.Add(1)
+		go func(i int, artifactType ArtifactType) {
+			defer waitGroup.Done()
+			list, err := FetchArtifactList(remote, artifactType)
+			results[i] = ArtifactListResult{ArtifactType: artifactType, List: list, Err: err}
+		}(i, artifactType)
+	}
+	waitGroup.Wait()
+	return results
+}
+
+// Fetch the Synapse configuration of an artifact from a remote
+// @param remote : Micro Integrator to fetch the configuration from
+// @param artifactType : type of the artifact, which must have config params
+// @param row : row of the artifact in the list of its type
+// @return configuration XML of the artifact
+// @return error if the configuration could not be fetched or the response does not hold one
+func FetchArtifactConfiguration(remote Remote, artifactType ArtifactType, row artifactUtils.Row) (string, error) {
+	var detail artifactUtils.ArtifactConfiguration
+	err := FetchRemoteData(remote, artifactType.Resource, artifactType.ConfigParams(row), &detail)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(detail.Configuration) == "" {
+		return "", errors.New("the server did not return a configuration")
+	}
+	return detail.Configuration, nil
+}
+
+// Fetch the Synapse configurations of all the artifacts of the given types from a remote, fetching a limited
+// number of configurations concurrently
+// @param remote : Micro Integrator to fetch the configurations from
+// @param artifactTypes : types of the artifacts, nil for all the types with config params
+// @return a result for each artifact, ordered by type and then as listed by the remote
+// @return error if a list of artifacts could not be fetched or a type has no config params
+func FetchArtifactConfigurations(remote Remote, artifactTypes []ArtifactType) ([]ArtifactConfigurationResult,
+	error) {
+	if artifactTypes == nil {
+		for _, artifactType := range ArtifactTypes {
+			if artifactType.ConfigParams != nil {
+				artifactTypes = append(artifactTypes, artifactType)
+			}
+		}
+	}
+	var results []ArtifactConfigurationResult
+	var rows []artifactUtils.Row
+	for _, result := range FetchArtifactLists(remote, artifactTypes) {
+		if result.ArtifactType.ConfigParams == nil {
+			return nil, errors.New("the configurations of " + result.ArtifactType.Name +
+				" artifacts are not available from the server")
+		}
+		if result.Err != nil {
+			return nil, errors.New("fetching " + result.ArtifactType.Name + " list failed: " + result.Err.Error())
+		}
+		for _, row := range result.List.GetRows() {
+			results = append(results, ArtifactConfigurationResult{ArtifactType: result.ArtifactType,
+				Name: cellAt(row, 0)})
+			rows = append(rows, row)
+		}
+	}
+
+	semaphore := make(chan struct{}, maxConcurrentDetailRequests)
+	var waitGroup sync.WaitGroup
+	for i := range results {
+		waitGroup.Add(1)
+		go func(result *ArtifactConfigurationResult, row artifactUtils.Row) {
+			defer waitGroup.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			result.Configuration, result.Err = FetchArtifactConfiguration(remote, result.ArtifactType, row)
+			if result.Err != nil {
+				result.Err = errors.New("fetching the configuration of " + result.ArtifactType.Name + " " +
+					result.Name + " failed: " + result.Err.Error())
+			}
+		}(&results[i], rows[i])
+	}
+	waitGroup.Wait()
+	return results, nil
+}
+
+// GetProblemRows returns the rows of a list which satisfy the problem condition of its type
+func GetProblemRows(artifactType ArtifactType, list artifactUtils.Table) []artifactUtils.Row {
+	if artifactType.ProblemCondition == "" {
+		return nil
+	}
+	rows, err := FilterRows(list.GetColumns(), list.GetRows(), []string{artifactType.ProblemCondition})
+	if err != nil {
+		HandleErrorAndExit("Invalid problem condition for "+artifactType.Name, err)
+	}
+	return rows
+}
+
+// FindArtifactRow returns the row of the named artifact in a list
+func FindArtifactRow(list artifactUtils.Table, name string) (artifactUtils.Row, bool) {
+	for _, row := range list.GetRows() {
+		if cellAt(row, 0) == name {
+			return row, true
+		}
+	}
+	return artifactUtils.Row{}, false
+}
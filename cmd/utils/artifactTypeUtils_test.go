@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// createRemoteServer starts a TLS server serving the given bodies keyed by management API resource
+func createRemoteServer(t *testing.T, bodies map[string]string) (*httptest.Server, Remote) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resource := strings.TrimPrefix(r.URL.Path, "/"+Context+"/")
+		// a body for the resource with its query, such as a single artifact, is preferred
+		body, found := bodies[resource+"?"+r.URL.RawQuery]
+		if !found {
+			body, found = bodies[resource]
+		}
+		w.Header().Set(HeaderContentType, HeaderValueApplicationJSON)
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"Error": "Not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	serverUrl, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal("Invalid server url: ", err)
+	}
+	return server, Remote{Url: serverUrl.Hostname(), Port: serverUrl.Port(), AccessToken: "token"}
+}
+
+func TestGetArtifactTypeAlias(t *testing.T) {
+	artifactType, err := GetArtifactType("capp")
+	if err != nil {
+		t.Fatal("Error finding artifact type: ", err)
+	}
+	AssertEqual(t, "compositeapp", artifactType.Name)
+
+	if _, err = GetArtifactType("flow"); err == nil {
+		t.Error("Expected an error for an unknown artifact type")
+	}
+}
+
+func TestFetchArtifactListsAndProblems(t *testing.T) {
+	server, remote := createRemoteServer(t, map[string]string{
+		PrefixEndpoints: `{"count": 2, "list": [{"name": "OrderEP", "type": "http", "isActive": true},
+			{"name": "StockEP", "type": "address", "isActive": false}]}`,
+		PrefixMessageStores: `{"count": 1, "list": [{"name": "OrderStore", "type": "jms", "size": 7}]}`,
+	})
+	defer server.Close()
+
+	endpointType, _ := GetArtifactType("endpoint")
+	storeType, _ := GetArtifactType("messagestore")
+	apiType, _ := GetArtifactType("api")
+	results := FetchArtifactLists(remote, []ArtifactType{endpointType, storeType, apiType})
+
+	AssertEqual(t, 3, len(results))
+	AssertEqual(t, "endpoint", results[0].ArtifactType.Name)
+	if results[0].Err != nil || results[1].Err != nil {
+		t.Fatal("Error fetching artifact lists: ", results[0].Err, results[1].Err)
+	}
+	if results[2].Err == nil {
+		t.Error("Expected an error for a missing resource")
+	}
+
+	problems := GetProblemRows(endpointType, results[0].List)
+	AssertEqual(t, 1, len(problems))
+	AssertEqual(t, "StockEP", problems[0].Cells[0])
+	AssertEqual(t, "Inactive", endpointType.Problem(problems[0]))
+
+	problems = GetProblemRows(storeType, results[1].List)
+	AssertEqual(t, "Not empty, 7 messages", storeType.Problem(problems[0]))
+}
+
+func TestFetchArtifactConfigurations(t *testing.T) {
+	server, remote := createRemoteServer(t, map[string]string{
+		PrefixEndpoints: `{"count": 2, "list": [{"name": "StockEP", "isActive": true}, ` +
+			`{"name": "PayEP", "isActive": true}]}`,
+		PrefixEndpoints + "?endpointName=StockEP": `{"name": "StockEP", ` +
+			`"configuration": "<endpoint name=\"StockEP\"/>"}`,
+		PrefixEndpoints + "?endpointName=PayEP": `{"name": "PayEP"}`,
+	})
+	defer server.Close()
+
+	endpointType, _ := GetArtifactType("endpoint")
+	results, err := FetchArtifactConfigurations(remote, []ArtifactType{endpointType})
+	if err != nil {
+		t.Fatal("Error fetching the configurations: ", err)
+	}
+	AssertEqual(t, 2, len(results))
+	AssertEqual(t, "StockEP", results[0].Name)
+	AssertEqual(t, `<endpoint name="StockEP"/>`, results[0].Configuration)
+	if results[1].Err == nil {
+		t.Error("Expected an error for an endpoint without a configuration")
+	}
+
+	connectorType, _ := GetArtifactType("connector")
+	if _, err = FetchArtifactConfigurations(remote, []ArtifactType{connectorType}); err == nil {
+		t.Error("Expected an error for a type without configurations")
+	}
+}
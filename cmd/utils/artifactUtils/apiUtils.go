@@ -1,8 +1,11 @@
 package artifactUtils
 
+import "net/url"
+
 type API struct {
 	Name      string     `json:"name"`
 	Url       string     `json:"url"`
+	Context   string     `json:"context"`
 	Version   string     `json:"version"`
 	Stats     string     `json:"stats"`
 	Tracing   string     `json:"tracing"`
@@ -24,19 +27,25 @@ type APISummary struct {
 	Url  string `json:"url"`
 }
 
-func (apis *APIList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
-
-	go func() {
-		for _, api := range apis.Apis {
-			ch <- []string{api.Name, api.Url}
-		}
-		close(ch)
-	}()
+func (apis *APIList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnUrl, NodeSpecific: true}}
+}
 
-	return ch
+func (apis *APIList) GetRows() []Row {
+	rows := make([]Row, 0, len(apis.Apis))
+	for _, api := range apis.Apis {
+		rows = append(rows, Row{Cells: []string{api.Name, api.Url}})
+	}
+	return rows
 }
 
-func (apis *APIList) GetCount() int32 {
-	return apis.Count
+func (api *API) GetProperties() map[string]string {
+	context := api.Context
+	if context == "" {
+		// older servers only return the URL of the API
+		if apiUrl, err := url.Parse(api.Url); err == nil {
+			context = apiUrl.Path
+		}
+	}
+	return map[string]string{"context": context, "version": api.Version, "stats": api.Stats, "tracing": api.Tracing}
 }
@@ -24,26 +24,18 @@ type Artifact struct {
 	Type string `json:"type"`
 }
 
-func (compositeApps *CompositeAppList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
-
-	go func() {
-		ch <- []string{"\n----------------------\nActive Composite Apps:\n----------------------"}
-		for _, compositeApp := range compositeApps.ActiveCompositeApps {
-			ch <- []string{compositeApp.Name, compositeApp.Version}
-		}
-		ch <- []string{"\n----------------------\nFaulty Composite Apps:\n----------------------"}
-		for _, compositeApp := range compositeApps.FaultyCompositeApps {
-			ch <- []string{compositeApp.Name, compositeApp.Version}
-		}
-		close(ch)
-	}()
-
-	return ch
+func (compositeApps *CompositeAppList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnVersion}, {Header: ColumnStatus, Status: true}}
 }
 
-
-func (compositeApps *CompositeAppList) GetCount() int32 {
-	return compositeApps.TotalCount
+func (compositeApps *CompositeAppList) GetRows() []Row {
+	rows := make([]Row, 0, len(compositeApps.ActiveCompositeApps)+len(compositeApps.FaultyCompositeApps))
+	for _, compositeApp := range compositeApps.ActiveCompositeApps {
+		rows = append(rows, Row{Cells: []string{compositeApp.Name, compositeApp.Version, "Active"}})
+	}
+	for _, compositeApp := range compositeApps.FaultyCompositeApps {
+		rows = append(rows, Row{Cells: []string{compositeApp.Name, compositeApp.Version, "Faulty"},
+			State: RowStateError})
+	}
+	return rows
 }
-
@@ -1,5 +1,7 @@
 package artifactUtils
 
+import "strings"
+
 type ConnectorList struct {
 	Count      int32              `json:"count"`
 	Connectors []ConnectorSummary `json:"list"`
@@ -12,19 +14,19 @@ type ConnectorSummary struct {
 	Description string `json:"description"`
 }
 
-func (connectors *ConnectorList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
-
-	go func() {
-		for _, connector := range connectors.Connectors {
-			ch <- []string{connector.Name, connector.Status, connector.Package, connector.Description}
-		}
-		close(ch)
-	}()
-
-	return ch
+func (connectors *ConnectorList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnStatus, Status: true}, {Header: ColumnPackage},
+		{Header: ColumnDescription}}
 }
 
-func (connectors *ConnectorList) GetCount() int32 {
-	return connectors.Count
+func (connectors *ConnectorList) GetRows() []Row {
+	rows := make([]Row, 0, len(connectors.Connectors))
+	for _, connector := range connectors.Connectors {
+		row := Row{Cells: []string{connector.Name, connector.Status, connector.Package, connector.Description}}
+		if !strings.EqualFold(connector.Status, "enabled") {
+			row.State = RowStateWarning
+		}
+		rows = append(rows, row)
+	}
+	return rows
 }
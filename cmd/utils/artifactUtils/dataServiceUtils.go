@@ -25,19 +25,15 @@ type QuerySummary struct {
 	Namespace string `json:"namespace"`
 }
 
-func (data *DataServicesList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
-
-	go func() {
-		for _, val := range data.List {
-			ch <- []string{val.ServiceName, val.Wsdl11, val.Wsdl20}
-		}
-		close(ch)
-	}()
-
-	return ch
+func (data *DataServicesList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnWsdl11, NodeSpecific: true},
+		{Header: ColumnWsdl20, NodeSpecific: true}}
 }
 
-func (data *DataServicesList) GetCount() int32 {
-	return data.Count
+func (data *DataServicesList) GetRows() []Row {
+	rows := make([]Row, 0, len(data.List))
+	for _, val := range data.List {
+		rows = append(rows, Row{Cells: []string{val.ServiceName, val.Wsdl11, val.Wsdl20}})
+	}
+	return rows
 }
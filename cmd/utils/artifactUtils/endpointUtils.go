@@ -20,6 +20,7 @@ type Endpoint struct {
 	Method string `json:"method"`
 	Url    string `json:"url"`
 	Stats  string `json:"stats"`
+	Tracing string `json:"tracing"`
 	Address    string `json:"address"`
 	URITemplate string `json:"uriTemplate"`
 	ServiceName string `json:"serviceName"`
@@ -27,19 +28,28 @@ type Endpoint struct {
 	WsdlURI string `json:"wsdlUri"`
 }
 
-func (endpoints *EndpointList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
+func (endpoints *EndpointList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnType}, {Header: ColumnActive, Status: true,
+		FilterAlias: "status", FilterAliasValue: getEndpointStatus}}
+}
 
-	go func() {
-		for _, endpoint := range endpoints.Endpoints {
-			ch <- []string{endpoint.Name, endpoint.Type, strconv.FormatBool(endpoint.Active)}
+func (endpoints *EndpointList) GetRows() []Row {
+	rows := make([]Row, 0, len(endpoints.Endpoints))
+	for _, endpoint := range endpoints.Endpoints {
+		row := Row{Cells: []string{endpoint.Name, endpoint.Type, strconv.FormatBool(endpoint.Active)}}
+		if !endpoint.Active {
+			row.State = RowStateWarning
 		}
-		close(ch)
-	}()
+		rows = append(rows, row)
+	}
+	return rows
+}
 
-	return ch
+func (endpoint *Endpoint) GetProperties() map[string]string {
+	return map[string]string{"stats": endpoint.Stats, "tracing": endpoint.Tracing}
 }
 
-func (endpoints *EndpointList) GetCount() int32 {
-	return endpoints.Count
+// the status of an endpoint as given to the update command, for the active column
+func getEndpointStatus(active string) string {
+	return getStateName(active == "true")
 }
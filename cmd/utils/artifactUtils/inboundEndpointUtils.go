@@ -16,6 +16,8 @@ type InboundEndpoint struct {
 	Stats      string      `json:"stats"`
 	Tracing    string      `json:"tracing"`
 	Parameters []Parameter `json:"parameters"`
+	// IsActive is nil for servers which do not report the state of inbound endpoints
+	IsActive *bool `json:"isActive,omitempty"`
 }
 
 type Parameter struct {
@@ -23,19 +25,22 @@ type Parameter struct {
 	Value string `json:"value"`
 }
 
-func (inboundEndpoints *InboundEndpointList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
-
-	go func() {
-		for _, inboundEndpoint := range inboundEndpoints.InboundEndpoints {
-			ch <- []string{inboundEndpoint.Name, inboundEndpoint.Type}
-		}
-		close(ch)
-	}()
+func (inboundEndpoints *InboundEndpointList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnType}}
+}
 
-	return ch
+func (inboundEndpoints *InboundEndpointList) GetRows() []Row {
+	rows := make([]Row, 0, len(inboundEndpoints.InboundEndpoints))
+	for _, inboundEndpoint := range inboundEndpoints.InboundEndpoints {
+		rows = append(rows, Row{Cells: []string{inboundEndpoint.Name, inboundEndpoint.Type}})
+	}
+	return rows
 }
 
-func (inboundEndpoints *InboundEndpointList) GetCount() int32 {
-	return inboundEndpoints.Count
+func (inboundEndpoint *InboundEndpoint) GetProperties() map[string]string {
+	properties := map[string]string{"stats": inboundEndpoint.Stats, "tracing": inboundEndpoint.Tracing}
+	if inboundEndpoint.IsActive != nil {
+		properties["state"] = getStateName(*inboundEndpoint.IsActive)
+	}
+	return properties
 }
@@ -16,19 +16,14 @@ type LocalEntryData struct {
 	Value string `json:"value"`
 }
 
-func (localEntries *LocalEntryList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
-
-	go func() {
-		for _, localEntry := range localEntries.LocalEntries {
-			ch <- []string{localEntry.Name, localEntry.Type}
-		}
-		close(ch)
-	}()
-
-	return ch
+func (localEntries *LocalEntryList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnType}}
 }
 
-func (localEntries *LocalEntryList) GetCount() int32 {
-	return localEntries.Count
+func (localEntries *LocalEntryList) GetRows() []Row {
+	rows := make([]Row, 0, len(localEntries.LocalEntries))
+	for _, localEntry := range localEntries.LocalEntries {
+		rows = append(rows, Row{Cells: []string{localEntry.Name, localEntry.Type}})
+	}
+	return rows
 }
@@ -27,19 +27,14 @@ type LogFile struct {
     Size string `json:"size"`
 }
 
-func (fileList *LogFileList) GetDataIterator() <-chan []string {
-    ch := make(chan []string)
-
-    go func() {
-        for _, logFile := range fileList.LogFiles {
-            ch <- []string{logFile.FileName, logFile.Size}
-        }
-        close(ch)
-    }()
-
-    return ch
+func (fileList *LogFileList) GetColumns() []Column {
+    return []Column{{Header: ColumnName}, {Header: ColumnSize}}
 }
 
-func (fileList *LogFileList) GetCount() int32 {
-    return fileList.Count
+func (fileList *LogFileList) GetRows() []Row {
+    rows := make([]Row, 0, len(fileList.LogFiles))
+    for _, logFile := range fileList.LogFiles {
+        rows = append(rows, Row{Cells: []string{logFile.FileName, logFile.Size}})
+    }
+    return rows
 }
@@ -1,5 +1,7 @@
 package artifactUtils
 
+import "strings"
+
 type MessageProcessorList struct {
 	Count             int32              `json:"count"`
 	MessageProcessors []MessageProcessor `json:"list"`
@@ -21,19 +23,18 @@ type MessageProcessorData struct {
 	Status     string            `json:"status"`
 }
 
-func (messageProcessors *MessageProcessorList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
-
-	go func() {
-		for _, messageProcessor := range messageProcessors.MessageProcessors {
-			ch <- []string{messageProcessor.Name, messageProcessor.Type, messageProcessor.Status}
-		}
-		close(ch)
-	}()
-
-	return ch
+func (messageProcessors *MessageProcessorList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnType}, {Header: ColumnStatus, Status: true}}
 }
 
-func (messageProcessors *MessageProcessorList) GetCount() int32 {
-	return messageProcessors.Count
+func (messageProcessors *MessageProcessorList) GetRows() []Row {
+	rows := make([]Row, 0, len(messageProcessors.MessageProcessors))
+	for _, messageProcessor := range messageProcessors.MessageProcessors {
+		row := Row{Cells: []string{messageProcessor.Name, messageProcessor.Type, messageProcessor.Status}}
+		if !strings.EqualFold(messageProcessor.Status, "active") {
+			row.State = RowStateWarning
+		}
+		rows = append(rows, row)
+	}
+	return rows
 }
@@ -23,19 +23,15 @@ type MessageStoreData struct {
 	Size       int               `json:"size"`
 }
 
-func (messageStores *MessageStoreList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
-
-	go func() {
-		for _, messageStore := range messageStores.MessageStores {
-			ch <- []string{messageStore.Name, messageStore.Type, strconv.Itoa(messageStore.Size)}
-		}
-		close(ch)
-	}()
-
-	return ch
+func (messageStores *MessageStoreList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnType}, {Header: ColumnSize, Numeric: true, Volatile: true}}
 }
 
-func (messageStores *MessageStoreList) GetCount() int32 {
-	return messageStores.Count
+func (messageStores *MessageStoreList) GetRows() []Row {
+	rows := make([]Row, 0, len(messageStores.MessageStores))
+	for _, messageStore := range messageStores.MessageStores {
+		rows = append(rows, Row{Cells: []string{messageStore.Name, messageStore.Type,
+			strconv.Itoa(messageStore.Size)}})
+	}
+	return rows
 }
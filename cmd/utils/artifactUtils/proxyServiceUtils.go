@@ -6,6 +6,8 @@ type Proxy struct {
 	Wsdl20  string `json:"wsdl2_0"`
 	Stats   string `json:"stats"`
 	Tracing string `json:"tracing"`
+	// IsRunning is nil for servers which do not report the state of proxy services
+	IsRunning *bool `json:"isRunning,omitempty"`
 }
 
 type ProxyServiceList struct {
@@ -19,19 +21,23 @@ type ProxySummary struct {
 	Wsdl20 string `json:"wsdl2_0"`
 }
 
-func (data *ProxyServiceList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
-
-	go func() {
-		for _, proxy := range data.Proxies {
-			ch <- []string{proxy.Name, proxy.Wsdl11, proxy.Wsdl20}
-		}
-		close(ch)
-	}()
+func (data *ProxyServiceList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnWsdl11, NodeSpecific: true},
+		{Header: ColumnWsdl20, NodeSpecific: true}}
+}
 
-	return ch
+func (data *ProxyServiceList) GetRows() []Row {
+	rows := make([]Row, 0, len(data.Proxies))
+	for _, proxy := range data.Proxies {
+		rows = append(rows, Row{Cells: []string{proxy.Name, proxy.Wsdl11, proxy.Wsdl20}})
+	}
+	return rows
 }
 
-func (data *ProxyServiceList) GetCount() int32 {
-	return data.Count
+func (proxy *Proxy) GetProperties() map[string]string {
+	properties := map[string]string{"stats": proxy.Stats, "tracing": proxy.Tracing}
+	if proxy.IsRunning != nil {
+		properties["state"] = getStateName(*proxy.IsRunning)
+	}
+	return properties
 }
@@ -20,19 +20,15 @@ type Sequence struct {
 	Mediators []string `json:"mediators"`
 }
 
-func (sequences *SequenceList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
-
-	go func() {
-		for _, sequence := range sequences.Sequences {
-			ch <- []string{sequence.Name, sequence.Stats, sequence.Tracing}
-		}
-		close(ch)
-	}()
-
-	return ch
+func (sequences *SequenceList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnStats}, {Header: ColumnTracing}, {Header: ColumnContainer, Wide: true}}
 }
 
-func (sequences *SequenceList) GetCount() int32 {
-	return sequences.Count
+func (sequences *SequenceList) GetRows() []Row {
+	rows := make([]Row, 0, len(sequences.Sequences))
+	for _, sequence := range sequences.Sequences {
+		rows = append(rows, Row{Cells: []string{sequence.Name, sequence.Stats, sequence.Tracing,
+			sequence.Container}})
+	}
+	return rows
 }
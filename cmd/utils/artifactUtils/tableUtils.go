@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package artifactUtils
+
+// Column titles of the artifact tables
+const (
+	ColumnName           = "NAME"
+	ColumnType           = "TYPE"
+	ColumnUrl            = "URL"
+	ColumnActive         = "Active"
+	ColumnMethod         = "METHOD"
+	ColumnStatus         = "STATUS"
+	ColumnSize           = "SIZE"
+	ColumnVersion        = "VERSION"
+	ColumnPackage        = "PACKAGE"
+	ColumnDescription    = "DESCRIPTION"
+	ColumnStats          = "STATS"
+	ColumnTracing        = "TRACING"
+	ColumnContainer      = "CONTAINER"
+	ColumnWsdl11         = "WSDL 1.1"
+	ColumnWsdl20         = "WSDL 2.0"
+	ColumnTriggerType    = "TRIGGER TYPE"
+	ColumnCount          = "COUNT"
+	ColumnInterval       = "INTERVAL"
+	ColumnCronExpression = "CRON EXPRESSION"
+	ColumnUserId         = "USER_ID"
+	ColumnMandatory      = "MANDATORY"
+	ColumnDefaultValue   = "DEFAULT VALUE"
+)
+
+// the name of the state of an artifact which can be activated and deactivated, as given to the update commands
+func getStateName(active bool) string {
+	if active {
+		return "active"
+	}
+	return "inactive"
+}
+
+// Column describes a single column of an artifact list table
+type Column struct {
+	// Header is printed as the column title and is used to select and sort columns
+	Header string
+	// Wide columns are only shown in wide mode or when selected explicitly
+	Wide bool
+	// Numeric columns are sorted by value instead of lexically
+	Numeric bool
+	// Status columns are colorized according to the state of the row
+	Status bool
+	// Volatile columns change at runtime, such as the size of a message store, and are left out of inventories
+	Volatile bool
+	// NodeSpecific columns hold values depending on the node, such as URLs, and are ignored when comparing nodes
+	NodeSpecific bool
+	// FilterAlias is another field name by which filters refer to the column, empty if it has none
+	FilterAlias string
+	// FilterAliasValue converts a cell to the value compared by the filters using the alias
+	FilterAliasValue func(cell string) string
+}
+
+// RowState classifies a row so that its status columns can be highlighted
+type RowState int
+
+const (
+	RowStateNormal RowState = iota
+	RowStateWarning
+	RowStateError
+)
+
+// Row holds the cell values of a single artifact, in the same order as the columns
+type Row struct {
+	Cells []string
+	State RowState
+}
+
+// Table is implemented by every artifact list that can be printed as a table
+type Table interface {
+	GetColumns() []Column
+	GetRows() []Row
+}
+
+// Detail is implemented by the detailed view of an artifact which holds properties missing in its summary
+type Detail interface {
+	GetProperties() map[string]string
+}
+
+// ArtifactConfiguration is the Synapse configuration returned with the detailed view of an artifact
+type ArtifactConfiguration struct {
+	Name          string `json:"name"`
+	Configuration string `json:"configuration"`
+}
@@ -13,19 +13,16 @@ type Task struct {
 	TriggerCron     string `json:"triggerCron"`
 }
 
-func (tasks *TaskList) GetDataIterator() <-chan []string {
-	ch := make(chan []string)
-
-	go func() {
-		for _, task := range tasks.Tasks {
-			ch <- []string{task.Name, task.Type, task.TriggerCount, task.TriggerInterval, task.TriggerCron}
-		}
-		close(ch)
-	}()
-
-	return ch
+func (tasks *TaskList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnTriggerType}, {Header: ColumnCount, Numeric: true},
+		{Header: ColumnInterval, Numeric: true}, {Header: ColumnCronExpression}}
 }
 
-func (tasks *TaskList) GetCount() int32 {
-	return tasks.Count
+func (tasks *TaskList) GetRows() []Row {
+	rows := make([]Row, 0, len(tasks.Tasks))
+	for _, task := range tasks.Tasks {
+		rows = append(rows, Row{Cells: []string{task.Name, task.Type, task.TriggerCount, task.TriggerInterval,
+			task.TriggerCron}})
+	}
+	return rows
 }
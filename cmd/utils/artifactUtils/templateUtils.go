@@ -29,3 +29,30 @@ type TemplateSequenceDetail struct {
 	IsMandatory  bool   `json:"mandatory"`
 	DefaultValue string `json:"defaultValue"`
 }
+
+func (templates *TemplateList) GetColumns() []Column {
+	return []Column{{Header: ColumnName}, {Header: ColumnType}}
+}
+
+func (templates *TemplateList) GetRows() []Row {
+	rows := make([]Row, 0, len(templates.SequenceTemplates)+len(templates.EndpointTemplates))
+	for _, template := range templates.SequenceTemplates {
+		rows = append(rows, Row{Cells: []string{template.Name, "Sequence"}})
+	}
+	for _, template := range templates.EndpointTemplates {
+		rows = append(rows, Row{Cells: []string{template.Name, "Endpoint"}})
+	}
+	return rows
+}
+
+func (templates *TemplateListByType) GetColumns() []Column {
+	return []Column{{Header: ColumnName}}
+}
+
+func (templates *TemplateListByType) GetRows() []Row {
+	rows := make([]Row, 0, len(templates.Templates))
+	for _, template := range templates.Templates {
+		rows = append(rows, Row{Cells: []string{template.Name}})
+	}
+	return rows
+}
@@ -32,19 +32,14 @@ type User struct {
     UserId   string `json:"userId"`
 }
 
-func (users *UserList) GetDataIterator() <-chan []string {
-    ch := make(chan []string)
-
-    go func() {
-        for _, user := range users.Users {
-            ch <- []string{user.UserId}
-        }
-        close(ch)
-    }()
-
-    return ch
+func (users *UserList) GetColumns() []Column {
+    return []Column{{Header: ColumnUserId}}
 }
 
-func (userList *UserList) GetCount() int32 {
-    return userList.Count
+func (users *UserList) GetRows() []Row {
+    rows := make([]Row, 0, len(users.Users))
+    for _, user := range users.Users {
+        rows = append(rows, Row{Cells: []string{user.UserId}})
+    }
+    return rows
 }
@@ -0,0 +1,167 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+)
+
+// ArtifactSelector selects artifacts of a type by their names, by filter expressions evaluated against the list
+// of the type and by the names listed in a file
+type ArtifactSelector struct {
+	Names    []string
+	Filters  []string
+	FromFile string
+}
+
+// BulkUpdateResult holds the outcome of updating a single artifact of a bulk update
+type BulkUpdateResult struct {
+	Name    string
+	Message string
+	Err     error
+}
+
+// IsEmpty reports whether the selector selects nothing
+func (selector ArtifactSelector) IsEmpty() bool {
+	return len(selector.Names) == 0 && len(selector.Filters) == 0 && selector.FromFile == ""
+}
+
+// Select the names of the artifacts of a type on a remote. The list of the type is only fetched for filters.
+// @param remote : Micro Integrator to select the artifacts of
+// @param artifactType : type of the artifacts
+// @param selector : names, filters and file selecting the artifacts
+// @return selected names without duplicates, in the order they were given or listed
+// @return error if the file cannot be read, the list cannot be fetched, a filter is invalid or matches nothing
+func SelectArtifactNames(remote Remote, artifactType ArtifactType, selector ArtifactSelector) ([]string, error) {
+	names := append([]string{}, selector.Names...)
+	if selector.FromFile != "" {
+		fileNames, err := ReadNamesFile(selector.FromFile)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, fileNames...)
+	}
+	if len(selector.Filters) > 0 {
+		list, err := FetchArtifactList(remote, artifactType)
+		if err != nil {
+			return nil, errors.New("fetching " + artifactType.Name + " list failed: " + err.Error())
+		}
+		rows, err := FilterRows(list.GetColumns(), list.GetRows(), selector.Filters)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			return nil, errors.New("no " + artifactType.Name + " matches " + strings.Join(selector.Filters, " and "))
+		}
+		for _, row := range rows {
+			names = append(names, cellAt(row, 0))
+		}
+	}
+
+	var selected []string
+	for _, name := range names {
+		if !ContainsString(selected, name) {
+			selected = append(selected, name)
+		}
+	}
+	return selected, nil
+}
+
+// Read the names listed in a file, one per line. Blank lines and lines starting with # are skipped.
+func ReadNamesFile(filePath string) ([]string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			names = append(names, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.New("reading " + filePath + " failed: " + err.Error())
+	}
+	if len(names) == 0 {
+		return nil, errors.New("no names are listed in " + filePath)
+	}
+	return names, nil
+}
+
+// Update the state of an artifact of a type which is activated and deactivated through the management API, such
+// as an endpoint. Unlike UpdateMIEndpoint, failures are returned to the caller instead of exiting.
+// @param remote : Micro Integrator to update the artifact of
+// @param artifactType : type of the artifact
+// @param name : name of the artifact
+// @param state : active or inactive
+// @return message of the server
+// @return error if the update failed
+func UpdateArtifactState(remote Remote, artifactType ArtifactType, name, state string) (string, error) {
+	return updateArtifact(remote, artifactType, map[string]string{"name": name, "status": state})
+}
+
+// post an update of an artifact to the resource of its type, returning the message of the server
+func updateArtifact(remote Remote, artifactType ArtifactType, body map[string]string) (string, error) {
+	url := GetRemoteRESTAPIBase(remote) + artifactType.Resource
+	Logln(LogPrefixInfo+"URL:", url)
+	headers := make(map[string]string)
+	headers[HeaderAuthorization] = HeaderValueAuthPrefixBearer + " " + remote.AccessToken
+
+	resp, err := InvokePOSTRequest(url, headers, body)
+	if err := checkResponseStatus(resp, err, url); err != nil {
+		return "", err
+	}
+	var data map[string]string
+	json.Unmarshal(resp.Body(), &data)
+	if data["Message"] == "" && data["Error"] != "" {
+		return "", errors.New(data["Error"])
+	}
+	return data["Message"], nil
+}
+
+// Run an update for each of the given names, running a limited number of updates concurrently
+// @param names : names of the artifacts to update
+// @param update : updates a single artifact, returning the message of the server
+// @return a result for each name, in the order of the names
+func RunBulkUpdate(names []string, update func(name string) (string, error)) []BulkUpdateResult {
+	results := make([]BulkUpdateResult, len(names))
+	semaphore := make(chan struct{}, maxConcurrentDetailRequests)
+	var waitGroup sync.WaitGroup
+	for i, name := range names {
+		waitGroup.Add(1)
+		go func(result *BulkUpdateResult, name string) {
+			defer waitGroup.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			result.Name = name
+			result.Message, result.Err = update(name)
+		}(&results[i], name)
+	}
+	waitGroup.Wait()
+	return results
+}
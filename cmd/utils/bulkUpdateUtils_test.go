@@ -0,0 +1,112 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSelectArtifactNames(t *testing.T) {
+	server, remote := createRemoteServer(t, map[string]string{
+		PrefixEndpoints: `{"count": 3, "list": [{"name": "OrderEP", "type": "http", "isActive": true},
+			{"name": "StockEP", "type": "address", "isActive": false},
+			{"name": "PayEP", "type": "http", "isActive": true}]}`,
+	})
+	defer server.Close()
+	endpointType, _ := GetArtifactType("endpoint")
+
+	dir, err := ioutil.TempDir("", "names")
+	if err != nil {
+		t.Fatal("Error creating temporary directory: ", err)
+	}
+	defer os.RemoveAll(dir)
+	namesFile := filepath.Join(dir, "names.txt")
+	if err = ioutil.WriteFile(namesFile, []byte("# incident\nPayEP\n\n  OrderEP \n"), 0644); err != nil {
+		t.Fatal("Error writing the names file: ", err)
+	}
+	names, err := SelectArtifactNames(remote, endpointType, ArtifactSelector{Names: []string{"OrderEP"},
+		Filters: []string{"type=http"}, FromFile: namesFile})
+	if err != nil {
+		t.Fatal("Error selecting names: ", err)
+	}
+	AssertEqual(t, "OrderEP PayEP", strings.Join(names, " "))
+
+	names, err = SelectArtifactNames(remote, endpointType, ArtifactSelector{Filters: []string{"name~^s"}})
+	if err != nil {
+		t.Fatal("Error selecting names: ", err)
+	}
+	AssertEqual(t, "StockEP", strings.Join(names, " "))
+
+	if _, err = SelectArtifactNames(remote, endpointType, ArtifactSelector{Filters: []string{"name=NoEP"}}); err == nil {
+		t.Error("Expected an error for a filter matching nothing")
+	}
+	if _, err = SelectArtifactNames(remote, endpointType, ArtifactSelector{FromFile: namesFile + ".missing"}); err == nil {
+		t.Error("Expected an error for a missing names file")
+	}
+}
+
+func TestRunBulkUpdate(t *testing.T) {
+	server, remote := createRemoteServer(t, map[string]string{
+		PrefixEndpoints: `{"Message": "Endpoint updated"}`,
+	})
+	defer server.Close()
+	endpointType, _ := GetArtifactType("endpoint")
+	message, err := UpdateArtifactState(remote, endpointType, "OrderEP", "inactive")
+	if err != nil {
+		t.Fatal("Error updating the state: ", err)
+	}
+	AssertEqual(t, "Endpoint updated", message)
+
+	results := RunBulkUpdate([]string{"OrderEP", "StockEP", "PayEP"}, func(name string) (string, error) {
+		if name == "StockEP" {
+			return "", errors.New("failed")
+		}
+		return name + " updated", nil
+	})
+	AssertEqual(t, 3, len(results))
+	AssertEqual(t, "OrderEP updated", results[0].Message)
+	AssertEqual(t, "StockEP", results[1].Name)
+	AssertEqual(t, "failed", results[1].Err.Error())
+	AssertEqual(t, "PayEP updated", results[2].Message)
+}
+
+func TestUpdateArtifactStateErrorPage(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html><body>Bad Gateway</body></html>"))
+	}))
+	defer server.Close()
+	serverUrl, _ := url.Parse(server.URL)
+	remote := Remote{Url: serverUrl.Hostname(), Port: serverUrl.Port(), AccessToken: "token"}
+	endpointType, _ := GetArtifactType("endpoint")
+
+	_, err := UpdateArtifactState(remote, endpointType, "OrderEP", "inactive")
+	if err == nil {
+		t.Fatal("Expected an error for a gateway error page")
+	}
+	AssertEqual(t, "502 Bad Gateway", err.Error())
+}
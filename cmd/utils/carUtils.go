@@ -0,0 +1,433 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CarArtifactsFileName is the descriptor at the root of a Composite App archive
+const CarArtifactsFileName = "artifacts.xml"
+
+// CarArtifactFileName is the metadata of an artifact in its own directory of a Composite App archive
+const CarArtifactFileName = "artifact.xml"
+
+// CarServerRole is the server role of the artifacts deployed in the Micro Integrator
+const CarServerRole = "EnterpriseIntegrator"
+
+// CarApplicationType is the artifact type of the Composite App itself in artifacts.xml
+const CarApplicationType = "carbon/application"
+
+// maximum size of a file in a Composite App archive read into memory, so that a corrupt or crafted archive declaring
+// an entry which expands to gigabytes is rejected instead of exhausting the memory
+var maxCarEntrySize int64 = 64 << 20
+
+// CarDescriptor is the artifacts.xml descriptor of a Composite App archive
+type CarDescriptor struct {
+	XMLName   xml.Name      `xml:"artifacts"`
+	Artifacts []CarArtifact `xml:"artifact"`
+}
+
+// CarArtifact is an artifact declared in an artifacts.xml or artifact.xml descriptor
+type CarArtifact struct {
+	XMLName      xml.Name        `xml:"artifact" json:"-"`
+	Name         string          `xml:"name,attr" json:"name"`
+	GroupId      string          `xml:"groupId,attr,omitempty" json:"groupId,omitempty"`
+	Version      string          `xml:"version,attr" json:"version"`
+	Type         string          `xml:"type,attr" json:"type"`
+	ServerRole   string          `xml:"serverRole,attr,omitempty" json:"serverRole,omitempty"`
+	Dependencies []CarDependency `xml:"dependency" json:"dependencies,omitempty"`
+	File         string          `xml:"file,omitempty" json:"file,omitempty"`
+}
+
+// CarDependency is a reference from the Composite App to one of its artifacts
+type CarDependency struct {
+	Artifact   string `xml:"artifact,attr" json:"artifact"`
+	Version    string `xml:"version,attr" json:"version"`
+	Include    bool   `xml:"include,attr" json:"include"`
+	ServerRole string `xml:"serverRole,attr,omitempty" json:"serverRole,omitempty"`
+}
+
+// CarFile is the content of a Composite App archive
+type CarFile struct {
+	Path        string            `json:"path"`
+	Application CarArtifact       `json:"application"`
+	Artifacts   []CarFileArtifact `json:"artifacts"`
+	files       map[string][]byte
+}
+
+// CarFileArtifact is an artifact bundled in a Composite App archive, described by its artifact.xml metadata
+type CarFileArtifact struct {
+	Name         string   `json:"name"`
+	Version      string   `json:"version"`
+	Type         string   `json:"type"`
+	ServerRole   string   `json:"serverRole"`
+	Dependencies []string `json:"dependencies"`
+	// Directory holds the artifact.xml of the artifact within the archive
+	Directory string `json:"directory"`
+	// File is the configuration of the artifact within the archive, empty if it is not bundled
+	File    string `json:"file"`
+	Missing bool   `json:"missing"`
+}
+
+// Get the artifact type name used by the management API for a type declared in artifact metadata,
+// such as api for synapse/api
+func GetCarArtifactTypeName(metadataType string) string {
+	parts := strings.SplitN(metadataType, "/", 2)
+	if len(parts) == 2 {
+		return strings.SplitN(parts[1], "/", 2)[0]
+	}
+	return metadataType
+}
+
+// Read a Composite App archive with the metadata of all its artifacts
+// @param carFilePath : path of the .car file
+// @return content of the archive, with artifacts in the order of the artifacts.xml dependencies
+// @return error if the file is not a valid Composite App archive
+func ReadCarFile(carFilePath string) (CarFile, error) {
+	archive, err := zip.OpenReader(carFilePath)
+	if err != nil {
+		return CarFile{}, errors.New(carFilePath + " is not a valid Composite App archive: " + err.Error())
+	}
+	defer archive.Close()
+
+	carFile := CarFile{Path: carFilePath, files: make(map[string][]byte)}
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		data, err := readCarEntry(file)
+		if err != nil {
+			return CarFile{}, errors.New("error reading " + file.Name + " in " + carFilePath + ": " + err.Error())
+		}
+		carFile.files[file.Name] = data
+	}
+	if err = carFile.load(); err != nil {
+		return CarFile{}, errors.New(carFilePath + ": " + err.Error())
+	}
+	return carFile, nil
+}
+
+// read a file of the archive, rejecting it once more than maxCarEntrySize bytes are read whatever size it declares
+func readCarEntry(file *zip.File) ([]byte, error) {
+	if file.UncompressedSize64 > uint64(maxCarEntrySize) {
+		return nil, errors.New("file is larger than " + strconv.FormatInt(maxCarEntrySize, 10) + " bytes")
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(reader, maxCarEntrySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxCarEntrySize {
+		return nil, errors.New("file is larger than " + strconv.FormatInt(maxCarEntrySize, 10) + " bytes")
+	}
+	return data, nil
+}
+
+// parse artifacts.xml and the artifact.xml metadata of each of its dependencies
+func (carFile *CarFile) load() error {
+	data, found := carFile.files[CarArtifactsFileName]
+	if !found {
+		return errors.New("archive does not contain " + CarArtifactsFileName)
+	}
+	var descriptor CarDescriptor
+	if err := xml.Unmarshal(data, &descriptor); err != nil {
+		return errors.New("invalid " + CarArtifactsFileName + ": " + err.Error())
+	}
+	found = false
+	for _, artifact := range descriptor.Artifacts {
+		if artifact.Type == CarApplicationType {
+			carFile.Application = artifact
+			found = true
+		}
+	}
+	if !found {
+		return errors.New(CarArtifactsFileName + " does not declare a " + CarApplicationType + " artifact")
+	}
+
+	metadata := make(map[string]CarFileArtifact)
+	for name, data := range carFile.files {
+		// entries of a zip archive are always separated by slashes
+		directory, fileName := path.Split(name)
+		directory = strings.TrimSuffix(directory, "/")
+		if fileName != CarArtifactFileName || directory == "" || strings.Contains(directory, "/") {
+			continue
+		}
+		var artifact CarArtifact
+		if err := xml.Unmarshal(data, &artifact); err != nil {
+			return errors.New("invalid " + name + ": " + err.Error())
+		}
+		fileArtifact := CarFileArtifact{Name: artifact.Name, Version: artifact.Version,
+			Type: GetCarArtifactTypeName(artifact.Type), ServerRole: artifact.ServerRole,
+			Dependencies: []string{}, Directory: directory}
+		if artifact.File != "" {
+			fileArtifact.File = directory + "/" + artifact.File
+		}
+		for _, dependency := range artifact.Dependencies {
+			fileArtifact.Dependencies = append(fileArtifact.Dependencies, dependency.Artifact)
+		}
+		metadata[artifact.Name+"_"+artifact.Version] = fileArtifact
+	}
+
+	for _, dependency := range carFile.Application.Dependencies {
+		artifact, found := metadata[dependency.Artifact+"_"+dependency.Version]
+		if !found {
+			artifact = CarFileArtifact{Name: dependency.Artifact, Version: dependency.Version,
+				ServerRole: dependency.ServerRole, Dependencies: []string{}, Missing: true}
+		}
+		carFile.Artifacts = append(carFile.Artifacts, artifact)
+	}
+	return nil
+}
+
+// ReadFile returns the content of a file within the archive
+func (carFile CarFile) ReadFile(name string) ([]byte, bool) {
+	data, found := carFile.files[name]
+	return data, found
+}
+
+// Read the name and version of the Composite App in an archive from its artifacts.xml
+// @param carFilePath : path of the .car file
+// @return the Composite App artifact
+// @return error if the file is not a valid Composite App archive
+func ReadCarApplication(carFilePath string) (CarArtifact, error) {
+	carFile, err := ReadCarFile(carFilePath)
+	if err != nil {
+		return CarArtifact{}, err
+	}
+	return carFile.Application, nil
+}
+
+// CarDiff holds the differences between two Composite App archives
+type CarDiff struct {
+	Old         string              `json:"old"`
+	New         string              `json:"new"`
+	Application CarArtifactChange   `json:"application"`
+	Changes     []CarArtifactChange `json:"changes"`
+}
+
+// CarArtifactChange is an artifact added, removed or changed between two Composite App archives
+type CarArtifactChange struct {
+	Kind       string `json:"kind"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	OldVersion string `json:"oldVersion,omitempty"`
+	NewVersion string `json:"newVersion,omitempty"`
+	// Diff holds the changed lines of the normalized configuration, with a few unchanged lines around them
+	Diff []string `json:"diff,omitempty"`
+}
+
+// number of unchanged lines printed around each change of a configuration
+const carDiffContext = 3
+
+// Find the artifacts added, removed or changed between two Composite App archives. The configurations of the
+// artifacts are compared after normalizing them, ignoring whitespace and attribute order.
+// @param old : earlier archive
+// @param new : later archive
+// @return differences, with the changes ordered by type and name
+// @return error if the configuration of an artifact is not well formed
+func DiffCarFiles(old, new CarFile) (CarDiff, error) {
+	diff := CarDiff{Old: old.Path, New: new.Path, Changes: []CarArtifactChange{},
+		Application: CarArtifactChange{Type: CarApplicationType, Name: new.Application.Name,
+			OldVersion: old.Application.Version, NewVersion: new.Application.Version}}
+	if old.Application.Name != new.Application.Name || old.Application.Version != new.Application.Version {
+		diff.Application.Kind = InventoryChangeChanged
+	}
+
+	oldArtifacts := make(map[string]CarFileArtifact)
+	for _, artifact := range old.Artifacts {
+		oldArtifacts[artifact.Type+"/"+artifact.Name] = artifact
+	}
+	newArtifacts := make(map[string]CarFileArtifact)
+	for _, artifact := range new.Artifacts {
+		newArtifacts[artifact.Type+"/"+artifact.Name] = artifact
+	}
+	var keys []string
+	for key := range oldArtifacts {
+		keys = append(keys, key)
+	}
+	for key := range newArtifacts {
+		if _, found := oldArtifacts[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		oldArtifact, inOld := oldArtifacts[key]
+		newArtifact, inNew := newArtifacts[key]
+		if !inNew {
+			diff.Changes = append(diff.Changes, CarArtifactChange{Kind: InventoryChangeRemoved,
+				Type: oldArtifact.Type, Name: oldArtifact.Name, OldVersion: oldArtifact.Version})
+			continue
+		}
+		if !inOld {
+			diff.Changes = append(diff.Changes, CarArtifactChange{Kind: InventoryChangeAdded,
+				Type: newArtifact.Type, Name: newArtifact.Name, NewVersion: newArtifact.Version})
+			continue
+		}
+		lines, err := diffCarArtifactFiles(old, oldArtifact, new, newArtifact)
+		if err != nil {
+			return CarDiff{}, err
+		}
+		if len(lines) > 0 || oldArtifact.Version != newArtifact.Version {
+			diff.Changes = append(diff.Changes, CarArtifactChange{Kind: InventoryChangeChanged,
+				Type: newArtifact.Type, Name: newArtifact.Name, OldVersion: oldArtifact.Version,
+				NewVersion: newArtifact.Version, Diff: lines})
+		}
+	}
+	return diff, nil
+}
+
+// diff the normalized configurations of an artifact, returning nil if they are equal
+func diffCarArtifactFiles(old CarFile, oldArtifact CarFileArtifact, new CarFile,
+	newArtifact CarFileArtifact) ([]string, error) {
+
+	oldLines, err := readNormalizedCarFile(old, oldArtifact)
+	if err != nil {
+		return nil, err
+	}
+	newLines, err := readNormalizedCarFile(new, newArtifact)
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	for i, hunk := range GetDiffHunks(DiffLines(oldLines, newLines), carDiffContext) {
+		if i > 0 {
+			lines = append(lines, "...")
+		}
+		for _, line := range hunk {
+			lines = append(lines, line.String())
+		}
+	}
+	return lines, nil
+}
+
+func readNormalizedCarFile(carFile CarFile, artifact CarFileArtifact) ([]string, error) {
+	data, found := carFile.ReadFile(artifact.File)
+	if artifact.File == "" || !found {
+		return nil, nil
+	}
+	if !strings.HasSuffix(strings.ToLower(artifact.File), ".xml") {
+		// compare other files such as libraries byte by byte
+		return []string{"<" + strconv.Itoa(len(data)) + " bytes, checksum " + checksum(data) + ">"}, nil
+	}
+	lines, err := NormalizeXML(data)
+	if err != nil {
+		return nil, errors.New("invalid configuration " + artifact.File + " in " + carFile.Path + ": " + err.Error())
+	}
+	return lines, nil
+}
+
+func checksum(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// Build a Composite App archive holding the given artifacts, each with its own artifact.xml metadata
+// @param artifacts : artifacts read from a Synapse artifact directory
+// @param application : name, group and version of the Composite App, also used for its artifacts
+// @param outputPath : path of the .car file to write
+// @return error if artifact names clash or the archive cannot be written
+func BuildCarFile(artifacts []SynapseArtifact, application CarArtifact, outputPath string) error {
+	application.Type = CarApplicationType
+	application.Dependencies = nil
+	files := make(map[string][]byte)
+	for _, artifact := range artifacts {
+		directory := artifact.Name + "_" + application.Version
+		if _, exists := files[directory+"/"+CarArtifactFileName]; exists {
+			return errors.New("more than one artifact is named " + artifact.Name)
+		}
+		fileName := path.Base(artifact.Path)
+		metadata, err := marshalCarDescriptor(CarArtifact{Name: artifact.Name, GroupId: application.GroupId,
+			Version: application.Version, Type: artifact.CarType, ServerRole: CarServerRole, File: fileName})
+		if err != nil {
+			return err
+		}
+		files[directory+"/"+CarArtifactFileName] = metadata
+		files[directory+"/"+fileName] = artifact.Content
+		application.Dependencies = append(application.Dependencies, CarDependency{Artifact: artifact.Name,
+			Version: application.Version, Include: true, ServerRole: CarServerRole})
+	}
+	descriptor, err := marshalCarDescriptor(CarDescriptor{Artifacts: []CarArtifact{application}})
+	if err != nil {
+		return err
+	}
+	files[CarArtifactsFileName] = descriptor
+
+	// write next to the output and rename, so that a failure does not leave a partial archive behind
+	output, err := ioutil.TempFile(filepath.Dir(outputPath), "."+filepath.Base(outputPath)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(output.Name())
+	err = writeCarEntries(output, files)
+	if closeErr := output.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(output.Name(), 0644)
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(output.Name(), outputPath)
+}
+
+func writeCarEntries(output io.Writer, files map[string][]byte) error {
+	writer := zip.NewWriter(output)
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		entry, err := writer.Create(name)
+		if err != nil {
+			return err
+		}
+		if _, err = entry.Write(files[name]); err != nil {
+			return err
+		}
+	}
+	return writer.Close()
+}
+
+func marshalCarDescriptor(descriptor interface{}) ([]byte, error) {
+	data, err := xml.MarshalIndent(descriptor, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// createCarFile writes an archive holding the given files to a temporary directory
+func createCarFile(t *testing.T, dir string, files map[string]string) string {
+	path := filepath.Join(dir, "test.car")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal("Error creating the archive: ", err)
+	}
+	defer file.Close()
+	writer := zip.NewWriter(file)
+	for name, content := range files {
+		entry, err := writer.Create(name)
+		if err != nil {
+			t.Fatal("Error adding to the archive: ", err)
+		}
+		entry.Write([]byte(content))
+	}
+	if err = writer.Close(); err != nil {
+		t.Fatal("Error closing the archive: ", err)
+	}
+	return path
+}
+
+func TestReadCarApplication(t *testing.T) {
+	dir, err := ioutil.TempDir("", "car")
+	if err != nil {
+		t.Fatal("Error creating a temporary directory: ", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := createCarFile(t, dir, map[string]string{CarArtifactsFileName: `<?xml version="1.0" encoding="UTF-8"?>
+<artifacts>
+    <artifact name="OrderCApp" version="1.0.0" type="carbon/application">
+        <dependency artifact="OrderAPI" version="1.0.0" include="true" serverRole="EnterpriseIntegrator"/>
+    </artifact>
+</artifacts>`})
+	application, err := ReadCarApplication(path)
+	if err != nil {
+		t.Fatal("Error reading the archive: ", err)
+	}
+	AssertEqual(t, "OrderCApp", application.Name)
+	AssertEqual(t, "1.0.0", application.Version)
+	AssertEqual(t, "OrderAPI", application.Dependencies[0].Artifact)
+
+	path = createCarFile(t, dir, map[string]string{"OrderAPI_1.0.0/artifact.xml": "<artifact/>"})
+	if _, err = ReadCarApplication(path); err == nil {
+		t.Error("Expected an error for an archive without " + CarArtifactsFileName)
+	}
+}
+
+func TestReadCarFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "car")
+	if err != nil {
+		t.Fatal("Error creating a temporary directory: ", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := createCarFile(t, dir, map[string]string{
+		CarArtifactsFileName: `<artifacts><artifact name="OrderCApp" version="1.0.0" type="carbon/application">
+			<dependency artifact="OrderAPI" version="1.0.0" include="true" serverRole="EnterpriseIntegrator"/>
+			<dependency artifact="OrderEP" version="1.0.0" include="true" serverRole="EnterpriseIntegrator"/>
+		</artifact></artifacts>`,
+		"OrderAPI_1.0.0/artifact.xml": `<artifact name="OrderAPI" version="1.0.0" type="synapse/api"
+			serverRole="EnterpriseIntegrator"><file>OrderAPI.xml</file></artifact>`,
+		"OrderAPI_1.0.0/OrderAPI.xml": `<api name="OrderAPI" context="/order"/>`,
+	})
+	carFile, err := ReadCarFile(path)
+	if err != nil {
+		t.Fatal("Error reading the archive: ", err)
+	}
+	AssertEqual(t, 2, len(carFile.Artifacts))
+	AssertEqual(t, "api", carFile.Artifacts[0].Type)
+	AssertEqual(t, "OrderAPI_1.0.0/OrderAPI.xml", carFile.Artifacts[0].File)
+	AssertEqual(t, true, carFile.Artifacts[1].Missing)
+
+	content, found := carFile.ReadFile(carFile.Artifacts[0].File)
+	AssertEqual(t, true, found)
+	AssertEqual(t, `<api name="OrderAPI" context="/order"/>`, string(content))
+}
+
+func TestReadCarFileEntrySizeLimit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "car")
+	if err != nil {
+		t.Fatal("Error creating a temporary directory: ", err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(size int64) { maxCarEntrySize = size }(maxCarEntrySize)
+	maxCarEntrySize = 128
+
+	path := createCarFile(t, dir, map[string]string{
+		CarArtifactsFileName:          `<artifacts><artifact name="OrderCApp" version="1.0.0" type="carbon/application"/></artifacts>`,
+		"OrderAPI_1.0.0/OrderAPI.xml": strings.Repeat("x", 129),
+	})
+	_, err = ReadCarFile(path)
+	if err == nil || !strings.Contains(err.Error(), "OrderAPI_1.0.0/OrderAPI.xml") {
+		t.Error("Expected an error for an archive entry over the size limit, got: ", err)
+	}
+}
+
+func TestGetCarArtifactTypeName(t *testing.T) {
+	AssertEqual(t, "proxy-service", GetCarArtifactTypeName("synapse/proxy-service"))
+	AssertEqual(t, "dataservice", GetCarArtifactTypeName("service/dataservice"))
+	AssertEqual(t, "synapse", GetCarArtifactTypeName("lib/synapse/mediator"))
+}
+
+func TestDiffCarFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "car")
+	if err != nil {
+		t.Fatal("Error creating a temporary directory: ", err)
+	}
+	defer os.RemoveAll(dir)
+
+	createCar := func(name, version, api string, withSequence bool) CarFile {
+		dependencies := `<dependency artifact="OrderAPI" version="1.0.0" include="true"/>`
+		files := map[string]string{
+			"OrderAPI_1.0.0/artifact.xml": `<artifact name="OrderAPI" version="1.0.0" type="synapse/api">
+				<file>OrderAPI.xml</file></artifact>`,
+			"OrderAPI_1.0.0/OrderAPI.xml": api,
+		}
+		if withSequence {
+			dependencies += `<dependency artifact="OrderSeq" version="1.0.0" include="true"/>`
+			files["OrderSeq_1.0.0/artifact.xml"] = `<artifact name="OrderSeq" version="1.0.0" type="synapse/sequence"/>`
+		}
+		files[CarArtifactsFileName] = `<artifacts><artifact name="OrderCApp" version="` + version +
+			`" type="carbon/application">` + dependencies + `</artifact></artifacts>`
+		carDir := filepath.Join(dir, name)
+		os.Mkdir(carDir, 0755)
+		carFile, err := ReadCarFile(createCarFile(t, carDir, files))
+		if err != nil {
+			t.Fatal("Error reading the archive: ", err)
+		}
+		return carFile
+	}
+
+	old := createCar("old", "1.0.0", `<api name="OrderAPI" context="/order"/>`, true)
+	same := createCar("same", "1.0.0", `<api  context="/order"
+		name="OrderAPI"></api>`, true)
+	diff, err := DiffCarFiles(old, same)
+	if err != nil {
+		t.Fatal("Error comparing the archives: ", err)
+	}
+	AssertEqual(t, "", diff.Application.Kind)
+	AssertEqual(t, 0, len(diff.Changes))
+
+	changed := createCar("changed", "1.0.1", `<api name="OrderAPI" context="/orders"/>`, false)
+	diff, err = DiffCarFiles(old, changed)
+	if err != nil {
+		t.Fatal("Error comparing the archives: ", err)
+	}
+	AssertEqual(t, InventoryChangeChanged, diff.Application.Kind)
+	AssertEqual(t, 2, len(diff.Changes))
+	AssertEqual(t, InventoryChangeChanged, diff.Changes[0].Kind)
+	AssertEqual(t, `- <api context="/order" name="OrderAPI"/>`, diff.Changes[0].Diff[0])
+	AssertEqual(t, `+ <api context="/orders" name="OrderAPI"/>`, diff.Changes[0].Diff[1])
+	AssertEqual(t, InventoryChangeRemoved, diff.Changes[1].Kind)
+	AssertEqual(t, "OrderSeq", diff.Changes[1].Name)
+}
@@ -0,0 +1,94 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Confirmation describes an operation which must be confirmed before it is run
+type Confirmation struct {
+	// Action describes the operation, such as "remove user guest"
+	Action string
+	// RemoteName is the name of the remote the operation changes
+	RemoteName string
+	// Remote is the remote the operation changes
+	Remote Remote
+}
+
+// IsStateReducing reports whether changing an artifact to the given state stops it from serving
+func IsStateReducing(state string) bool {
+	return strings.EqualFold(state, DesiredStateInactive) || strings.EqualFold(state, TaskStatePause)
+}
+
+// ConfirmCurrentRemoteOperation asks the user to confirm an operation on the current remote, exiting if it is
+// refused. See ConfirmOperation.
+func ConfirmCurrentRemoteOperation(action string, assumeYes bool) {
+	ConfirmOperation(Confirmation{Action: action, RemoteName: RemoteConfigData.CurrentRemote,
+		Remote: RemoteConfigData.Remotes[RemoteConfigData.CurrentRemote]}, assumeYes)
+}
+
+// ConfirmOperation asks the user to confirm an operation on a remote. Protected remotes are confirmed by typing
+// the name of the remote. Nothing is asked when assumeYes is set or in a dry run, which makes no changes, and the
+// operation is refused when stdin is not a terminal. Exits when the operation is not confirmed.
+func ConfirmOperation(confirmation Confirmation, assumeYes bool) {
+	if assumeYes || DryRun {
+		return
+	}
+	if !IsTerminal(os.Stdin) {
+		HandleErrorAndExit("Refusing to "+confirmation.Action+" on "+confirmation.RemoteName+
+			" without confirmation as stdin is not a terminal. Use --yes to confirm.", nil)
+	}
+	confirmed, err := confirmOperation(bufio.NewReader(os.Stdin), os.Stdout, confirmation)
+	if err != nil {
+		HandleErrorAndExit("Unable to read the confirmation.", err)
+	}
+	if !confirmed {
+		fmt.Println("Cancelled, nothing was changed")
+		os.Exit(1)
+	}
+}
+
+// prompt for the confirmation of an operation and read the answer
+// @return true if the operation is confirmed
+func confirmOperation(reader *bufio.Reader, out io.Writer, confirmation Confirmation) (bool, error) {
+	target := confirmation.RemoteName + " (" + confirmation.Remote.Url + ":" + confirmation.Remote.Port + ")"
+	if confirmation.Remote.Protected {
+		fmt.Fprintf(out, "Remote %s is protected. Type its name to %s: ", target, confirmation.Action)
+	} else {
+		fmt.Fprintf(out, "Remote %s: %s? [y/N]: ", target, confirmation.Action)
+	}
+	answer, err := reader.ReadString('\n')
+	if err != nil && (err != io.EOF || answer == "") {
+		if err == io.EOF {
+			return false, errors.New("no answer was given")
+		}
+		return false, err
+	}
+	answer = strings.TrimSpace(answer)
+	if confirmation.Remote.Protected {
+		return answer == confirmation.RemoteName, nil
+	}
+	return strings.EqualFold(answer, "y") || strings.EqualFold(answer, "yes"), nil
+}
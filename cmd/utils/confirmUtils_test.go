@@ -0,0 +1,71 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConfirmOperation(t *testing.T) {
+	confirm := func(answer string, protected bool) (bool, string) {
+		var out bytes.Buffer
+		confirmation := Confirmation{Action: "remove user guest", RemoteName: "prod",
+			Remote: Remote{Url: "localhost", Port: "9164", Protected: protected}}
+		confirmed, err := confirmOperation(bufio.NewReader(strings.NewReader(answer)), &out, confirmation)
+		if err != nil {
+			t.Fatal("Error reading the confirmation: ", err)
+		}
+		return confirmed, out.String()
+	}
+
+	confirmed, prompt := confirm("y\n", false)
+	AssertEqual(t, true, confirmed)
+	AssertEqual(t, "Remote prod (localhost:9164): remove user guest? [y/N]: ", prompt)
+	confirmed, _ = confirm("YES", false)
+	AssertEqual(t, true, confirmed)
+	confirmed, _ = confirm("\n", false)
+	AssertEqual(t, false, confirmed)
+
+	confirmed, prompt = confirm("y\n", true)
+	AssertEqual(t, false, confirmed)
+	AssertEqual(t, "Remote prod (localhost:9164) is protected. Type its name to remove user guest: ", prompt)
+	confirmed, _ = confirm(" prod\n", true)
+	AssertEqual(t, true, confirmed)
+
+	_, err := confirmOperation(bufio.NewReader(strings.NewReader("")), &bytes.Buffer{}, Confirmation{})
+	if err == nil {
+		t.Error("Expected an error when no answer is given")
+	}
+}
+
+func TestIsStateReducing(t *testing.T) {
+	AssertEqual(t, true, IsStateReducing("Inactive"))
+	AssertEqual(t, true, IsStateReducing("pause"))
+	AssertEqual(t, false, IsStateReducing("active"))
+}
+
+func TestConfirmOperationDryRun(t *testing.T) {
+	DryRun = true
+	defer func() { DryRun = false }()
+	// stdin is not a terminal under go test, so the operation would be refused if it was asked for
+	ConfirmOperation(Confirmation{Action: "remove user guest", RemoteName: "prod"}, false)
+}
@@ -104,24 +104,15 @@ const PrefixTransactions = "transactions"
 const TransactionCountCmd = "count"
 const TransactionReportCmd = "report"
 
-const Name = "NAME"
-const Type = "TYPE"
-const Url = "URL"
-const IsActive = "Active"
-const Method = "METHOD"
-const Status = "STATUS"
-const Size = "SIZE"
-const Version = "VERSION"
-const Package = "PACKAGE"
-const Description = "DESCRIPTION"
-const Stats = "STATS"
-const Tracing = "TRACING"
-const Wsdl11 = "WSDL 1.1"
-const Wsdl20 = "WSDL 2.0"
-const TriggerType = "TRIGGER TYPE"
-const Count = "COUNT"
-const Interval = "INTERVAL"
-const CronExpression = "CRON EXPRESSION"
-const UserId = "USER_ID"
-const IsMandatory = "MANDATORY"
-const DefaultValue = "DEFAULT VALUE"
+// Task states and actions
+const TaskStatePause = "pause"
+const TaskStateResume = "resume"
+const TaskActionTrigger = "trigger"
+
+// Output formats
+const OutputFormatText = "text"
+const OutputFormatTable = "table"
+const OutputFormatJSON = "json"
+const OutputFormatSARIF = "sarif"
+const OutputFormatDOT = "dot"
+const OutputFormatMermaid = "mermaid"
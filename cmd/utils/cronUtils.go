@@ -0,0 +1,320 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// years supported by Quartz cron expressions
+const minCronYear = 1970
+const maxCronYear = 2099
+
+var cronMonthNames = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+var cronDayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// QuartzCron is a Quartz cron expression of the fields seconds, minutes, hours, day of month, month, day of week
+// and an optional year, such as 0 0/15 9-17 ? * MON-FRI
+type QuartzCron struct {
+	expression  string
+	seconds     []bool
+	minutes     []bool
+	hours       []bool
+	months      []bool
+	years       []bool
+	daysOfMonth cronDaysOfMonth
+	daysOfWeek  cronDaysOfWeek
+}
+
+// days of month selected by a list of days, L, L-[offset], LW or [day]W
+type cronDaysOfMonth struct {
+	any            bool
+	days           []bool
+	last           bool
+	lastOffset     int
+	lastWeekday    bool
+	nearestWeekday int
+}
+
+// days of week selected by a list of days, [day]L or [day]#[n]. Days are numbered from 1 for Sunday.
+type cronDaysOfWeek struct {
+	any      bool
+	days     []bool
+	lastDay  int
+	nthDay   int
+	nthIndex int
+}
+
+// Parse a Quartz cron expression
+// @param expression : cron expression of 6 or 7 fields
+// @return parsed expression
+// @return error if the expression is invalid or uses a feature Quartz does not support
+func ParseQuartzCron(expression string) (*QuartzCron, error) {
+	fields := strings.Fields(strings.ToUpper(expression))
+	if len(fields) != 6 && len(fields) != 7 {
+		return nil, errors.New("invalid cron expression '" + expression + "': expected 6 or 7 fields but found " +
+			strconv.Itoa(len(fields)))
+	}
+	cron := &QuartzCron{expression: expression}
+	var err error
+	parsers := []struct {
+		name  string
+		parse func(field string) error
+	}{
+		{"seconds", func(field string) (err error) { cron.seconds, err = parseCronValues(field, 0, 59, nil); return }},
+		{"minutes", func(field string) (err error) { cron.minutes, err = parseCronValues(field, 0, 59, nil); return }},
+		{"hours", func(field string) (err error) { cron.hours, err = parseCronValues(field, 0, 23, nil); return }},
+		{"day of month", func(field string) (err error) { cron.daysOfMonth, err = parseCronDaysOfMonth(field); return }},
+		{"month", func(field string) (err error) {
+			cron.months, err = parseCronValues(field, 1, 12, cronMonthNames)
+			return
+		}},
+		{"day of week", func(field string) (err error) { cron.daysOfWeek, err = parseCronDaysOfWeek(field); return }},
+		{"year", func(field string) (err error) {
+			cron.years, err = parseCronValues(field, minCronYear, maxCronYear, nil)
+			return
+		}},
+	}
+	for i, field := range fields {
+		if err = parsers[i].parse(field); err != nil {
+			return nil, errors.New("invalid " + parsers[i].name + " '" + field + "' in cron expression '" +
+				expression + "': " + err.Error())
+		}
+	}
+	// Quartz requires ? for exactly one of day of month and day of week
+	if (fields[3] == "?") == (fields[5] == "?") {
+		return nil, errors.New("invalid cron expression '" + expression + "': use ? for exactly one of the day " +
+			"of month and the day of week")
+	}
+	return cron, nil
+}
+
+// String returns the expression the cron was parsed from
+func (cron *QuartzCron) String() string {
+	return cron.expression
+}
+
+// Next returns the first time after the given time at which the cron fires, in the location of the given time
+// @return false if the cron does not fire before the end of the last supported year
+func (cron *QuartzCron) Next(after time.Time) (time.Time, bool) {
+	location := after.Location()
+	t := after.Truncate(time.Second).Add(time.Second)
+	for t.Year() <= maxCronYear {
+		year, month, day := t.Date()
+		hour, minute, second := t.Clock()
+		var next time.Time
+		switch {
+		case cron.years != nil && !cron.years[year-minCronYear]:
+			next = time.Date(year+1, time.January, 1, 0, 0, 0, 0, location)
+		case !cron.months[int(month)-1]:
+			next = time.Date(year, month+1, 1, 0, 0, 0, 0, location)
+		case !cron.matchesDay(t):
+			next = time.Date(year, month, day+1, 0, 0, 0, 0, location)
+		case !cron.hours[hour]:
+			next = time.Date(year, month, day, hour+1, 0, 0, 0, location)
+		case !cron.minutes[minute]:
+			next = time.Date(year, month, day, hour, minute+1, 0, 0, location)
+		case !cron.seconds[second]:
+			next = time.Date(year, month, day, hour, minute, second+1, 0, location)
+		default:
+			return t, true
+		}
+		// a wall clock time skipped by a daylight saving change may be normalized to an earlier instant
+		if !next.After(t) {
+			next = t.Add(time.Second)
+		}
+		t = next
+	}
+	return time.Time{}, false
+}
+
+// reports whether the cron fires on the day of the given time
+func (cron *QuartzCron) matchesDay(t time.Time) bool {
+	year, month, day := t.Date()
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	daysOfMonth := cron.daysOfMonth
+	switch {
+	case daysOfMonth.any:
+	case daysOfMonth.last:
+		if day != lastDay-daysOfMonth.lastOffset {
+			return false
+		}
+	case daysOfMonth.lastWeekday:
+		if day != getNearestWeekday(year, month, lastDay, lastDay) {
+			return false
+		}
+	case daysOfMonth.nearestWeekday > 0:
+		if daysOfMonth.nearestWeekday > lastDay ||
+			day != getNearestWeekday(year, month, daysOfMonth.nearestWeekday, lastDay) {
+			return false
+		}
+	case !daysOfMonth.days[day-1]:
+		return false
+	}
+
+	daysOfWeek := cron.daysOfWeek
+	weekday := int(t.Weekday()) + 1
+	switch {
+	case daysOfWeek.any:
+		return true
+	case daysOfWeek.lastDay > 0:
+		return weekday == daysOfWeek.lastDay && day+7 > lastDay
+	case daysOfWeek.nthDay > 0:
+		return weekday == daysOfWeek.nthDay && (day-1)/7+1 == daysOfWeek.nthIndex
+	}
+	return daysOfWeek.days[weekday-1]
+}
+
+// find the weekday nearest to a day of a month without leaving the month
+func getNearestWeekday(year int, month time.Month, day, lastDay int) int {
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
+
+// Parse a cron field of comma separated values, ranges such as MON-FRI and increments such as 0/15 or 10-40/5
+// @param field : field to parse
+// @param min : smallest value of the field
+// @param max : largest value of the field
+// @param names : names of the values starting from min, nil if the values have no names
+// @return a flag for each value from min to max, set for the values the field selects
+func parseCronValues(field string, min, max int, names []string) ([]bool, error) {
+	values := make([]bool, max-min+1)
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		hasStep := false
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return nil, errors.New("invalid increment '" + part[i+1:] + "'")
+			}
+			part, hasStep = part[:i], true
+		}
+
+		var start, end int
+		if part == "*" {
+			start, end = min, max
+		} else if i := strings.Index(part, "-"); i > 0 {
+			var err error
+			if start, err = parseCronValue(part[:i], min, max, names); err != nil {
+				return nil, err
+			}
+			if end, err = parseCronValue(part[i+1:], min, max, names); err != nil {
+				return nil, err
+			}
+		} else {
+			var err error
+			if start, err = parseCronValue(part, min, max, names); err != nil {
+				return nil, err
+			}
+			end = start
+			if hasStep {
+				end = max
+			}
+		}
+
+		// a range ending before its start wraps around, such as 22-2 for hours
+		span := end - start
+		if span < 0 {
+			span += max - min + 1
+		}
+		for offset := 0; offset <= span; offset += step {
+			value := start + offset
+			if value > max {
+				value -= max - min + 1
+			}
+			values[value-min] = true
+		}
+	}
+	return values, nil
+}
+
+// parse a single value of a cron field, given as a number or a name
+func parseCronValue(text string, min, max int, names []string) (int, error) {
+	for i, name := range names {
+		if text == name {
+			return min + i, nil
+		}
+	}
+	value, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, errors.New("invalid value '" + text + "'")
+	}
+	if value < min || value > max {
+		return 0, errors.New("value " + text + " is out of the range " + strconv.Itoa(min) + "-" + strconv.Itoa(max))
+	}
+	return value, nil
+}
+
+func parseCronDaysOfMonth(field string) (cronDaysOfMonth, error) {
+	var days cronDaysOfMonth
+	var err error
+	switch {
+	case field == "?" || field == "*":
+		days.any = true
+	case field == "L":
+		days.last = true
+	case field == "LW":
+		days.lastWeekday = true
+	case strings.HasPrefix(field, "L-"):
+		days.last = true
+		days.lastOffset, err = parseCronValue(field[2:], 0, 30, nil)
+	case strings.HasSuffix(field, "W"):
+		days.nearestWeekday, err = parseCronValue(strings.TrimSuffix(field, "W"), 1, 31, nil)
+	default:
+		days.days, err = parseCronValues(field, 1, 31, nil)
+	}
+	return days, err
+}
+
+func parseCronDaysOfWeek(field string) (cronDaysOfWeek, error) {
+	var days cronDaysOfWeek
+	var err error
+	switch {
+	case field == "?" || field == "*":
+		days.any = true
+	case field == "L":
+		days.days = make([]bool, 7)
+		days.days[6] = true
+	case strings.HasSuffix(field, "L"):
+		days.lastDay, err = parseCronValue(strings.TrimSuffix(field, "L"), 1, 7, cronDayNames)
+	case strings.Contains(field, "#"):
+		i := strings.Index(field, "#")
+		if days.nthDay, err = parseCronValue(field[:i], 1, 7, cronDayNames); err == nil {
+			days.nthIndex, err = parseCronValue(field[i+1:], 1, 5, nil)
+		}
+	default:
+		days.days, err = parseCronValues(field, 1, 7, cronDayNames)
+	}
+	return days, err
+}
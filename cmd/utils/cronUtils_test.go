@@ -0,0 +1,82 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func nextCronTimes(t *testing.T, expression string, after time.Time, count int) string {
+	cron, err := ParseQuartzCron(expression)
+	if err != nil {
+		t.Fatal("Error parsing cron expression "+expression+": ", err)
+	}
+	var times []string
+	for i := 0; i < count; i++ {
+		next, found := cron.Next(after)
+		if !found {
+			break
+		}
+		times = append(times, next.Format("2006-01-02 15:04:05 Mon"))
+		after = next
+	}
+	return strings.Join(times, ", ")
+}
+
+func TestQuartzCronNext(t *testing.T) {
+	// Wednesday 15 January 2020
+	after := time.Date(2020, time.January, 15, 10, 7, 30, 0, time.UTC)
+	AssertEqual(t, "2020-01-15 10:15:00 Wed, 2020-01-15 10:30:00 Wed, 2020-01-15 10:45:00 Wed",
+		nextCronTimes(t, "0 0/15 * * * ?", after, 3))
+	AssertEqual(t, "2020-01-15 12:00:00 Wed, 2020-01-16 12:00:00 Thu, 2020-01-17 12:00:00 Fri, "+
+		"2020-01-20 12:00:00 Mon", nextCronTimes(t, "0 0 12 ? * MON-FRI", after, 4))
+	AssertEqual(t, "2020-01-31 00:00:00 Fri, 2020-02-29 00:00:00 Sat", nextCronTimes(t, "0 0 0 L * ?", after, 2))
+	AssertEqual(t, "2020-01-29 00:00:00 Wed", nextCronTimes(t, "0 0 0 L-2 * ?", after, 1))
+	// 15 February 2020 is a Saturday and 15 March 2020 is a Sunday
+	AssertEqual(t, "2020-02-14 09:00:00 Fri, 2020-03-16 09:00:00 Mon", nextCronTimes(t, "0 0 9 15W 2-3 ?", after, 2))
+	AssertEqual(t, "2020-01-31 08:00:00 Fri, 2020-02-28 08:00:00 Fri", nextCronTimes(t, "0 0 8 LW * ?", after, 2))
+	AssertEqual(t, "2020-01-17 06:00:00 Fri, 2020-02-21 06:00:00 Fri", nextCronTimes(t, "0 0 6 ? * 6#3", after, 2))
+	AssertEqual(t, "2020-01-31 06:00:00 Fri", nextCronTimes(t, "0 0 6 ? * 6L", after, 1))
+	AssertEqual(t, "2020-01-15 23:00:00 Wed, 2020-01-16 00:00:00 Thu, 2020-01-16 01:00:00 Thu",
+		nextCronTimes(t, "0 0 23-1 * * ?", after, 3))
+	AssertEqual(t, "2021-01-01 00:00:00 Fri", nextCronTimes(t, "0 0 0 1 JAN ? 2021-2022", after, 1))
+	AssertEqual(t, "", nextCronTimes(t, "0 0 0 1 JAN ? 2019", after, 1))
+}
+
+func TestQuartzCronNextTimeZone(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("Time zone database is not available: ", err)
+	}
+	cron, _ := ParseQuartzCron("0 0 2 * * ?")
+	// the clocks of Berlin jump from 02:00 to 03:00 on 29 March 2020
+	next, _ := cron.Next(time.Date(2020, time.March, 28, 12, 0, 0, 0, berlin))
+	AssertEqual(t, "2020-03-30 02:00:00 CEST", next.Format("2006-01-02 15:04:05 MST"))
+}
+
+func TestParseQuartzCronErrors(t *testing.T) {
+	for _, expression := range []string{"", "0 0 * * *", "0 0 12 * * MON", "0 60 * * * ?", "0 0 12 ? * FOO",
+		"0 0 12 32 * ?", "0 0 12 ? * 2#6", "0 0/0 * * * ?", "0 0 12 ? ? ?", "0 0 12 ? * ?", "0 0 12 * * *", "0 0 12 1 * ? 1969 1"} {
+		if _, err := ParseQuartzCron(expression); err == nil {
+			t.Error("Expected an error for cron expression '" + expression + "'")
+		}
+	}
+}
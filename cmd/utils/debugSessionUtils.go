@@ -0,0 +1,317 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+	"gopkg.in/yaml.v2"
+)
+
+// DebugSessionFileName is the file in the configuration directory holding the active debug sessions
+const DebugSessionFileName = "mi_cli_debug_sessions.yaml"
+
+// name of the logger whose level is inherited by classes without a logger of their own
+const rootLoggerName = "root"
+
+// DebugSessions holds the active debug sessions, at most one for each remote
+type DebugSessions struct {
+	Sessions []DebugSession `yaml:"sessions"`
+}
+
+// DebugSession records the log levels and tracing changed on a remote for a limited time, together with the values
+// to revert them to. Only the changes which were made are recorded.
+type DebugSession struct {
+	Remote    string                 `yaml:"remote"`
+	Started   time.Time              `yaml:"started"`
+	Expires   time.Time              `yaml:"expires"`
+	Loggers   []DebugSessionLogger   `yaml:"loggers,omitempty"`
+	Artifacts []DebugSessionArtifact `yaml:"artifacts,omitempty"`
+}
+
+// DebugSessionLogger is a logger whose level is changed by a debug session
+type DebugSessionLogger struct {
+	Name  string `yaml:"name"`
+	Class string `yaml:"class"`
+	Level string `yaml:"level"`
+	// OriginalLevel is the level to revert to. A logger added by the session reverts to the level its class
+	// inherited before.
+	OriginalLevel string `yaml:"originalLevel"`
+	Added         bool   `yaml:"added,omitempty"`
+}
+
+// DebugSessionArtifact is an artifact whose tracing is enabled by a debug session
+type DebugSessionArtifact struct {
+	Type string `yaml:"type"`
+	Name string `yaml:"name"`
+	// OriginalTracing is enable or disable, the tracing to revert to
+	OriginalTracing string `yaml:"originalTracing"`
+}
+
+// IsEmpty reports whether the session changes nothing
+func (session DebugSession) IsEmpty() bool {
+	return len(session.Loggers) == 0 && len(session.Artifacts) == 0
+}
+
+// Get the path of the file holding the active debug sessions, creating the configuration directory if needed
+func GetDebugSessionFilePath() string {
+	userHomeDir, err := os.UserHomeDir()
+	if err != nil {
+		HandleErrorAndExit("Error getting user home directory: ", err)
+	}
+	configDirectory := filepath.Join(userHomeDir, ConfigDirName)
+	MakeDirectoryIfNotExists(configDirectory)
+	return filepath.Join(configDirectory, DebugSessionFileName)
+}
+
+// Read the active debug sessions from a file. A missing file holds no sessions.
+func LoadDebugSessions(filePath string) (DebugSessions, error) {
+	var sessions DebugSessions
+	data, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return sessions, nil
+	} else if err != nil {
+		return sessions, err
+	}
+	if err = yaml.Unmarshal(data, &sessions); err != nil {
+		return sessions, errors.New(filePath + " is not a valid debug session file: " + err.Error())
+	}
+	return sessions, nil
+}
+
+// Save writes the sessions to a file, removing the file when no session is active
+func (sessions DebugSessions) Save(filePath string) error {
+	if len(sessions.Sessions) == 0 {
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	data, err := yaml.Marshal(sessions)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filePath, data, 0600)
+}
+
+// Get finds the session of a remote
+func (sessions DebugSessions) Get(remoteName string) (DebugSession, bool) {
+	for _, session := range sessions.Sessions {
+		if session.Remote == remoteName {
+			return session, true
+		}
+	}
+	return DebugSession{}, false
+}
+
+// Put replaces the session of the remote of a session, removing it if the session changes nothing
+func (sessions *DebugSessions) Put(session DebugSession) {
+	var kept []DebugSession
+	for _, existing := range sessions.Sessions {
+		if existing.Remote != session.Remote {
+			kept = append(kept, existing)
+		}
+	}
+	if !session.IsEmpty() {
+		kept = append(kept, session)
+	}
+	sessions.Sessions = kept
+}
+
+// Resolve the loggers of a debug session, given as [logger]=[level] where the logger is the name or the class of
+// a logger such as org.apache.synapse=DEBUG. A class without a logger of its own is added as a logger named after
+// the class.
+// @param specs : loggers and levels
+// @param loggers : loggers configured on the remote
+// @return loggers of the session with their original levels
+// @return error if a spec or a level is invalid
+func PlanDebugSessionLoggers(specs []string, loggers []Logger) ([]DebugSessionLogger, error) {
+	var planned []DebugSessionLogger
+	for _, spec := range specs {
+		i := strings.LastIndex(spec, "=")
+		if i <= 0 {
+			return nil, errors.New("invalid logger '" + spec + "', expected [logger]=[level] such as " +
+				"org.apache.synapse=DEBUG")
+		}
+		key, level := spec[:i], strings.ToUpper(spec[i+1:])
+		if !ContainsString(logLevels, level) {
+			return nil, errors.New("invalid level '" + spec[i+1:] + "' of logger " + key + ", expected one of " +
+				strings.Join(logLevels, ", "))
+		}
+		logger := DebugSessionLogger{Name: key, Class: key, Level: level}
+		found := false
+		for _, existing := range loggers {
+			if existing.LoggerName == key || existing.ComponentName == key {
+				logger.Name, logger.Class, logger.OriginalLevel = existing.LoggerName, existing.ComponentName,
+					strings.ToUpper(existing.LogLevel)
+				found = true
+				break
+			}
+		}
+		if !found {
+			inherited, err := getInheritedLogLevel(key, loggers)
+			if err != nil {
+				return nil, err
+			}
+			logger.Name = strings.Replace(key, ".", "-", -1)
+			logger.OriginalLevel, logger.Added = inherited, true
+		}
+		planned = append(planned, logger)
+	}
+	return planned, nil
+}
+
+// get the level a class inherits from the logger of its closest package, or from the root logger
+func getInheritedLogLevel(class string, loggers []Logger) (string, error) {
+	level, root, length := "", "", -1
+	for _, logger := range loggers {
+		if logger.LoggerName == rootLoggerName {
+			root = logger.LogLevel
+		} else if strings.HasPrefix(class, logger.ComponentName+".") && len(logger.ComponentName) > length {
+			level, length = logger.LogLevel, len(logger.ComponentName)
+		}
+	}
+	if level == "" {
+		level = root
+	}
+	if level == "" {
+		return "", errors.New("cannot find the level inherited by " + class + ", the root logger is not listed")
+	}
+	return strings.ToUpper(level), nil
+}
+
+// Get whether tracing of an artifact is enabled
+// @param remote : Micro Integrator the artifact is deployed in
+// @param artifact : artifact of a type supporting tracing
+// @return enable or disable
+// @return error if the artifact cannot be fetched
+func FetchArtifactTracing(remote Remote, artifact ArtifactReference) (string, error) {
+	var detail map[string]interface{}
+	params := artifact.ArtifactType.ConfigParams(artifactUtils.Row{Cells: []string{artifact.Name}})
+	if err := FetchRemoteData(remote, artifact.ArtifactType.Resource, params, &detail); err != nil {
+		return "", errors.New("getting " + artifact.String() + " failed: " + err.Error())
+	}
+	if tracing, _ := detail["tracing"].(string); strings.EqualFold(tracing, "enabled") {
+		return PropertyValueEnable, nil
+	}
+	return PropertyValueDisable, nil
+}
+
+// Set the level of a logger of a remote, adding the logger if a class is given
+// @return message of the server
+// @return error if the update failed
+func UpdateRemoteLogger(remote Remote, loggerName, level, class string) (string, error) {
+	url := GetRemoteRESTAPIBase(remote) + PrefixLogging
+	Logln(LogPrefixInfo+"URL:", url)
+	headers := make(map[string]string)
+	headers[HeaderAuthorization] = HeaderValueAuthPrefixBearer + " " + remote.AccessToken
+	body := map[string]string{"loggerName": loggerName, "loggingLevel": level}
+	if class != "" {
+		body["loggerClass"] = class
+	}
+
+	resp, err := InvokeUPDATERequest(url, headers, body)
+	if err := checkResponseStatus(resp, err, url); err != nil {
+		return "", err
+	}
+	var data map[string]string
+	json.Unmarshal(resp.Body(), &data)
+	if data["message"] == "" && data["Error"] != "" {
+		return "", errors.New(data["Error"])
+	}
+	return data["message"], nil
+}
+
+// Make the changes of a planned debug session, recording each change once it is made
+// @param remote : Micro Integrator of the session
+// @param planned : session with the loggers and the artifacts to change
+// @param record : called with the changes made so far after each change
+// @return changes made
+// @return error if a change failed or could not be recorded, the changes made before are not reverted
+func ApplyDebugSession(remote Remote, planned DebugSession, record func(applied DebugSession) error) (DebugSession,
+	error) {
+	applied := planned
+	applied.Loggers, applied.Artifacts = nil, nil
+	for _, logger := range planned.Loggers {
+		class := ""
+		if logger.Added {
+			class = logger.Class
+		}
+		if _, err := UpdateRemoteLogger(remote, logger.Name, logger.Level, class); err != nil {
+			return applied, errors.New("setting the level of logger " + logger.Name + " failed: " + err.Error())
+		}
+		applied.Loggers = append(applied.Loggers, logger)
+		if err := record(applied); err != nil {
+			return applied, err
+		}
+	}
+	for _, artifact := range planned.Artifacts {
+		artifactType, err := GetArtifactType(artifact.Type)
+		if err == nil {
+			_, err = UpdateArtifactProperty(remote, artifactType, artifact.Name, ArtifactPropertyTracing,
+				PropertyValueEnable)
+		}
+		if err != nil {
+			return applied, errors.New("enabling tracing of " + artifact.Type + "/" + artifact.Name + " failed: " +
+				err.Error())
+		}
+		applied.Artifacts = append(applied.Artifacts, artifact)
+		if err := record(applied); err != nil {
+			return applied, err
+		}
+	}
+	return applied, nil
+}
+
+// Revert the changes of a debug session
+// @param remote : Micro Integrator of the session
+// @param session : session to revert
+// @return changes which could not be reverted
+// @return error for each change which could not be reverted
+func RevertDebugSession(remote Remote, session DebugSession) (DebugSession, []error) {
+	remaining := session
+	remaining.Loggers, remaining.Artifacts = nil, nil
+	var errs []error
+	for _, logger := range session.Loggers {
+		if _, err := UpdateRemoteLogger(remote, logger.Name, logger.OriginalLevel, ""); err != nil {
+			remaining.Loggers = append(remaining.Loggers, logger)
+			errs = append(errs, errors.New("reverting the level of logger "+logger.Name+" failed: "+err.Error()))
+		}
+	}
+	for _, artifact := range session.Artifacts {
+		artifactType, err := GetArtifactType(artifact.Type)
+		if err == nil {
+			_, err = UpdateArtifactProperty(remote, artifactType, artifact.Name, ArtifactPropertyTracing,
+				artifact.OriginalTracing)
+		}
+		if err != nil {
+			remaining.Artifacts = append(remaining.Artifacts, artifact)
+			errs = append(errs, errors.New("reverting tracing of "+artifact.Type+"/"+artifact.Name+" failed: "+
+				err.Error()))
+		}
+	}
+	return remaining, errs
+}
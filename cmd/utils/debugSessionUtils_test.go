@@ -0,0 +1,160 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var debugSessionTestLoggers = []Logger{
+	{LoggerName: "root", ComponentName: "root", LogLevel: "INFO"},
+	{LoggerName: "org-apache-synapse", ComponentName: "org.apache.synapse", LogLevel: "WARN"},
+	{LoggerName: "synapse-transport", ComponentName: "org.apache.synapse.transport", LogLevel: "error"},
+}
+
+func TestPlanDebugSessionLoggers(t *testing.T) {
+	loggers, err := PlanDebugSessionLoggers([]string{"org.apache.synapse=debug", "synapse-transport=TRACE",
+		"org.apache.synapse.transport.nhttp=DEBUG", "org.apache.axis2=DEBUG"}, debugSessionTestLoggers)
+	if err != nil {
+		t.Fatal("Error planning the loggers: ", err)
+	}
+	var planned []string
+	for _, logger := range loggers {
+		planned = append(planned, logger.Name+" "+logger.Class+" "+logger.Level+" "+logger.OriginalLevel)
+		AssertEqual(t, logger.Class != "org.apache.synapse" && logger.Class != "org.apache.synapse.transport",
+			logger.Added)
+	}
+	AssertEqual(t, "org-apache-synapse org.apache.synapse DEBUG WARN, "+
+		"synapse-transport org.apache.synapse.transport TRACE ERROR, "+
+		"org-apache-synapse-transport-nhttp org.apache.synapse.transport.nhttp DEBUG ERROR, "+
+		"org-apache-axis2 org.apache.axis2 DEBUG INFO", strings.Join(planned, ", "))
+
+	for _, invalid := range []string{"org.apache.synapse", "=DEBUG", "org.apache.synapse=LOUD"} {
+		if _, err = PlanDebugSessionLoggers([]string{invalid}, debugSessionTestLoggers); err == nil {
+			t.Error("Expected an error for the logger " + invalid)
+		}
+	}
+	if _, err = PlanDebugSessionLoggers([]string{"org.apache.axis2=DEBUG"}, nil); err == nil {
+		t.Error("Expected an error for a class without an inherited level")
+	}
+}
+
+func TestDebugSessionsSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sessions")
+	if err != nil {
+		t.Fatal("Error creating temporary directory: ", err)
+	}
+	defer os.RemoveAll(dir)
+	filePath := filepath.Join(dir, DebugSessionFileName)
+
+	sessions, err := LoadDebugSessions(filePath)
+	if err != nil {
+		t.Fatal("Error loading missing sessions: ", err)
+	}
+	AssertEqual(t, 0, len(sessions.Sessions))
+
+	started := time.Date(2020, time.January, 15, 10, 0, 0, 0, time.UTC)
+	session := DebugSession{Remote: "node1", Started: started, Expires: started.Add(15 * time.Minute),
+		Loggers:   []DebugSessionLogger{{Name: "root", Class: "root", Level: "DEBUG", OriginalLevel: "INFO"}},
+		Artifacts: []DebugSessionArtifact{{Type: "api", Name: "OrderAPI", OriginalTracing: PropertyValueDisable}}}
+	sessions.Put(session)
+	sessions.Put(DebugSession{Remote: "node2", Started: started,
+		Artifacts: []DebugSessionArtifact{{Type: "sequence", Name: "main", OriginalTracing: PropertyValueEnable}}})
+	if err = sessions.Save(filePath); err != nil {
+		t.Fatal("Error saving the sessions: ", err)
+	}
+
+	loaded, err := LoadDebugSessions(filePath)
+	if err != nil {
+		t.Fatal("Error loading the sessions: ", err)
+	}
+	AssertEqual(t, 2, len(loaded.Sessions))
+	saved, found := loaded.Get("node1")
+	AssertEqual(t, true, found)
+	AssertEqual(t, true, saved.Started.Equal(started))
+	AssertEqual(t, true, saved.Expires.Equal(session.Expires))
+	AssertEqual(t, session.Loggers[0], saved.Loggers[0])
+	AssertEqual(t, session.Artifacts[0], saved.Artifacts[0])
+
+	loaded.Put(DebugSession{Remote: "node1"})
+	loaded.Put(DebugSession{Remote: "node2"})
+	AssertEqual(t, 0, len(loaded.Sessions))
+	if err = loaded.Save(filePath); err != nil {
+		t.Fatal("Error saving the sessions: ", err)
+	}
+	AssertEqual(t, false, IsFileExist(filePath))
+}
+
+func TestApplyAndRevertDebugSession(t *testing.T) {
+	var requests []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/"+Context+"/")+" "+
+			body["name"]+body["loggerName"]+" "+body["trace"]+body["loggingLevel"]+body["loggerClass"])
+		w.Header().Set(HeaderContentType, HeaderValueApplicationJSON)
+		if body["name"] == "BrokenAPI" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"Error": "BrokenAPI not found"}`))
+			return
+		}
+		w.Write([]byte(`{"message": "Updated", "Message": "Updated"}`))
+	}))
+	defer server.Close()
+	serverUrl, _ := url.Parse(server.URL)
+	remote := Remote{Url: serverUrl.Hostname(), Port: serverUrl.Port(), AccessToken: "token"}
+
+	planned := DebugSession{Remote: "node1",
+		Loggers: []DebugSessionLogger{{Name: "org-apache-axis2", Class: "org.apache.axis2", Level: "DEBUG",
+			OriginalLevel: "INFO", Added: true}},
+		Artifacts: []DebugSessionArtifact{{Type: "api", Name: "OrderAPI", OriginalTracing: PropertyValueDisable},
+			{Type: "api", Name: "BrokenAPI", OriginalTracing: PropertyValueDisable}}}
+	recorded := 0
+	applied, err := ApplyDebugSession(remote, planned, func(applied DebugSession) error {
+		recorded++
+		return nil
+	})
+	if err == nil {
+		t.Fatal("Expected an error for an artifact which cannot be updated")
+	}
+	AssertEqual(t, 2, recorded)
+	AssertEqual(t, 1, len(applied.Loggers))
+	AssertEqual(t, 1, len(applied.Artifacts))
+
+	applied.Artifacts = append(applied.Artifacts, planned.Artifacts[1])
+	remaining, errs := RevertDebugSession(remote, applied)
+	AssertEqual(t, 1, len(errs))
+	AssertEqual(t, 0, len(remaining.Loggers))
+	AssertEqual(t, "BrokenAPI", remaining.Artifacts[0].Name)
+	AssertEqual(t, "PATCH logging org-apache-axis2 DEBUGorg.apache.axis2\n"+
+		"POST apis OrderAPI enable\n"+
+		"POST apis BrokenAPI enable\n"+
+		"PATCH logging org-apache-axis2 INFO\n"+
+		"POST apis OrderAPI disable\n"+
+		"POST apis BrokenAPI disable", strings.Join(requests, "\n"))
+}
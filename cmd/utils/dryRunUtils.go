@@ -0,0 +1,291 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+	"gopkg.in/resty.v1"
+)
+
+// DryRun is set when the requests changing the server should be printed instead of sent
+var DryRun bool
+
+// DryRunMessage is the message of the responses to the requests which were not sent
+const DryRunMessage = "Dry run, the request was not sent"
+
+const dryRunPrefix = "[DRY RUN] "
+const dryRunRedacted = "<redacted>"
+
+// headers whose values are replaced when a request is printed
+var dryRunSecretHeaders = []string{HeaderAuthorization, "Cookie", "Proxy-Authorization"}
+
+// resources which change the server although they are requested with GET
+var dryRunMutatingGetResources = []string{LogoutResource}
+
+// dryRunTransport sends the requests reading from the server and prints the other requests instead of sending
+// them, answering them as if the server accepted them. A request changing an artifact is answered with 404 when
+// the artifact does not exist, as the server would.
+type dryRunTransport struct {
+	next  http.RoundTripper
+	out   io.Writer
+	mutex sync.Mutex
+}
+
+// EnableDryRunMode routes all the following requests through the dry run transport
+func EnableDryRunMode() {
+	DryRun = true
+	AllowInsecureSSLConnection()
+	resty.SetTransport(&dryRunTransport{next: resty.DefaultClient.GetClient().Transport, out: os.Stdout})
+}
+
+func (transport *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isReadingRequest(req) {
+		return transport.next.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	transport.print(req, body)
+
+	if resp, err := transport.checkTarget(req, body); resp != nil || err != nil {
+		return resp, err
+	}
+	return newDryRunResponse(req, http.StatusOK, map[string]string{"Message": DryRunMessage,
+		"message": DryRunMessage}), nil
+}
+
+// a request only reads from the server when it uses a safe method on a resource which is not changed by reading it
+func isReadingRequest(req *http.Request) bool {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead && req.Method != http.MethodOptions {
+		return false
+	}
+	resource := strings.TrimPrefix(req.URL.Path, "/"+Context+"/")
+	for _, mutating := range dryRunMutatingGetResources {
+		if resource == mutating {
+			return false
+		}
+	}
+	return true
+}
+
+// print the method, url, headers and body of a request, with the credentials redacted
+func (transport *dryRunTransport) print(req *http.Request, body []byte) {
+	var text strings.Builder
+	text.WriteString(dryRunPrefix + req.Method + " " + req.URL.String() + "\n")
+	var names []string
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range req.Header[name] {
+			text.WriteString("  " + name + ": " + redactHeader(name, value) + "\n")
+		}
+	}
+	if len(body) > 0 {
+		text.WriteString(describeDryRunBody(req.Header.Get(HeaderContentType), body) + "\n")
+	}
+
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+	fmt.Fprint(transport.out, text.String())
+}
+
+// replace the credentials of a header, keeping the authentication scheme
+func redactHeader(name, value string) string {
+	for _, secret := range dryRunSecretHeaders {
+		if strings.EqualFold(name, secret) {
+			if fields := strings.Fields(value); len(fields) > 1 {
+				return fields[0] + " " + dryRunRedacted
+			}
+			return dryRunRedacted
+		}
+	}
+	return value
+}
+
+// describe the body of a request, listing the parts of a multipart body instead of their content and redacting
+// the passwords of a JSON body
+func describeDryRunBody(contentType string, body []byte) string {
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	if strings.HasPrefix(mediaType, "multipart/") && params["boundary"] != "" {
+		var parts []string
+		reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				break
+			}
+			size, _ := io.Copy(ioutil.Discard, part)
+			description := "  part " + part.FormName()
+			if part.FileName() != "" {
+				description += ": file " + part.FileName()
+			}
+			parts = append(parts, description+" ("+strconv.FormatInt(size, 10)+" bytes)")
+		}
+		return strings.Join(parts, "\n")
+	}
+
+	var fields map[string]interface{}
+	if json.Unmarshal(body, &fields) == nil {
+		redacted := false
+		for key := range fields {
+			if strings.Contains(strings.ToLower(key), "password") {
+				fields[key] = dryRunRedacted
+				redacted = true
+			}
+		}
+		if redacted {
+			var buffer bytes.Buffer
+			encoder := json.NewEncoder(&buffer)
+			encoder.SetEscapeHTML(false)
+			encoder.Encode(fields)
+			body = bytes.TrimSpace(buffer.Bytes())
+		}
+	}
+	return "  " + string(body)
+}
+
+// check that the artifact, logger or user changed by a request exists
+// @return a 404 response if it does not exist, or the response of the server if it could not be checked
+func (transport *dryRunTransport) checkTarget(req *http.Request, body []byte) (*http.Response, error) {
+	resource := strings.TrimPrefix(req.URL.Path, "/"+Context+"/")
+	name := ""
+	if i := strings.Index(resource, "/"); i >= 0 {
+		resource, name = resource[:i], resource[i+1:]
+	}
+	var fields map[string]string
+	json.Unmarshal(body, &fields)
+
+	var description string
+	var getNames func(data []byte) ([]string, error)
+	if resource == PrefixLogging && name == "" && fields["loggerName"] != "" && fields["loggerClass"] == "" {
+		name, description = fields["loggerName"], "logger "+fields["loggerName"]
+		getNames = func(data []byte) ([]string, error) {
+			var loggers LoggerList
+			err := json.Unmarshal(data, &loggers)
+			var names []string
+			for _, logger := range loggers.Loggers {
+				names = append(names, logger.LoggerName)
+			}
+			return names, err
+		}
+	} else if resource == PrefixUsers && name != "" && req.Method == http.MethodDelete {
+		description = "user " + name
+		getNames = func(data []byte) ([]string, error) {
+			var users artifactUtils.UserList
+			err := json.Unmarshal(data, &users)
+			var names []string
+			for _, user := range users.Users {
+				names = append(names, user.UserId)
+			}
+			return names, err
+		}
+	} else {
+		for _, artifactType := range ArtifactTypes {
+			if artifactType.Resource != resource {
+				continue
+			}
+			if name == "" && req.Method != http.MethodDelete {
+				name = fields["name"]
+			}
+			if name == "" {
+				break
+			}
+			newList := artifactType.NewList
+			description = artifactType.Name + " " + name
+			getNames = func(data []byte) ([]string, error) {
+				list := newList()
+				err := json.Unmarshal(data, list)
+				var names []string
+				for _, row := range list.GetRows() {
+					names = append(names, cellAt(row, 0))
+				}
+				return names, err
+			}
+		}
+	}
+	if getNames == nil {
+		return nil, nil
+	}
+
+	listUrl := *req.URL
+	listUrl.Path = "/" + Context + "/" + resource
+	listUrl.RawQuery = ""
+	listReq, err := http.NewRequest(http.MethodGet, listUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	listReq.Header.Set(HeaderAuthorization, req.Header.Get(HeaderAuthorization))
+	resp, err := transport.next.RoundTrip(listReq)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	names, err := getNames(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON response from %s. Reason: %v", listUrl.String(), err)
+	}
+	if !ContainsString(names, name) {
+		return newDryRunResponse(req, http.StatusNotFound,
+			map[string]string{"Error": strings.ToUpper(description[:1]) + description[1:] + " does not exist"}), nil
+	}
+	return nil, nil
+}
+
+// create a JSON response to a request which was not sent
+func newDryRunResponse(req *http.Request, statusCode int, body map[string]string) *http.Response {
+	data, _ := json.Marshal(body)
+	return &http.Response{
+		Status:        strconv.Itoa(statusCode) + " " + http.StatusText(statusCode),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{HeaderContentType: []string{HeaderValueApplicationJSON}},
+		Body:          ioutil.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       req,
+	}
+}
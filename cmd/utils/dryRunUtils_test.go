@@ -0,0 +1,82 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDryRunTransport(t *testing.T) {
+	server, remote := createRemoteServer(t, map[string]string{
+		PrefixEndpoints: `{"count": 1, "list": [{"name": "StockEP", "type": "address", "isActive": true}]}`,
+		PrefixUsers:     `{"count": 1, "list": [{"userId": "admin"}]}`,
+	})
+	defer server.Close()
+	var out bytes.Buffer
+	client := &http.Client{Transport: &dryRunTransport{next: server.Client().Transport, out: &out}}
+	base := GetRemoteRESTAPIBase(remote)
+
+	send := func(method, url, body string) (*http.Response, string) {
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		if err != nil {
+			t.Fatal("Error creating request: ", err)
+		}
+		req.Header.Set(HeaderAuthorization, HeaderValueAuthPrefixBearer+" token")
+		req.Header.Set(HeaderContentType, HeaderValueApplicationJSON)
+		out.Reset()
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal("Error sending request: ", err)
+		}
+		resp.Body.Close()
+		return resp, out.String()
+	}
+
+	resp, printed := send(http.MethodPost, base+PrefixEndpoints, `{"name":"StockEP","status":"inactive"}`)
+	AssertEqual(t, http.StatusOK, resp.StatusCode)
+	AssertEqual(t, "[DRY RUN] POST "+base+PrefixEndpoints+"\n"+
+		"  Authorization: Bearer <redacted>\n"+
+		"  Content-Type: application/json\n"+
+		`  {"name":"StockEP","status":"inactive"}`+"\n", printed)
+
+	resp, _ = send(http.MethodPost, base+PrefixEndpoints, `{"name":"OrderEP","status":"inactive"}`)
+	AssertEqual(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, _ = send(http.MethodDelete, base+PrefixUsers+"/admin", "")
+	AssertEqual(t, http.StatusOK, resp.StatusCode)
+	resp, _ = send(http.MethodDelete, base+PrefixUsers+"/guest", "")
+	AssertEqual(t, http.StatusNotFound, resp.StatusCode)
+
+	_, printed = send(http.MethodPost, base+PrefixUsers, `{"userId":"ops","password":"secret"}`)
+	AssertEqual(t, false, strings.Contains(printed, "secret"))
+	AssertEqual(t, true, strings.Contains(printed, `"password":"<redacted>"`))
+
+	// requests reading from the server are sent
+	resp, printed = send(http.MethodGet, base+PrefixEndpoints, "")
+	AssertEqual(t, http.StatusOK, resp.StatusCode)
+	AssertEqual(t, "", printed)
+
+	// logging out invalidates the token although it is a GET
+	resp, printed = send(http.MethodGet, base+LogoutResource, "")
+	AssertEqual(t, http.StatusOK, resp.StatusCode)
+	AssertEqual(t, true, strings.HasPrefix(printed, "[DRY RUN] GET "+base+LogoutResource+"\n"))
+}
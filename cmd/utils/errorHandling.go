@@ -26,18 +26,28 @@ import (
 var IsVerbose bool
 
 func HandleErrorAndExit(msg string, err error) {
+	HandleErrorAndExitWithStatus(msg, err, 1)
+}
+
+// HandleErrorAndExitWithStatus prints an error like HandleErrorAndExit and exits with the given status, for the
+// commands which use status 1 for a result such as finding differences
+func HandleErrorAndExitWithStatus(msg string, err error, status int) {
 	if err == nil {
 		fmt.Fprintf(os.Stderr, "%s: %v\n", ProjectName, msg)
 	} else {
 		fmt.Fprintf(os.Stderr, "%s: %v Reason: %v\n", ProjectName, msg, err.Error())
 	}
-	defer printAndExit()
+	if watch.polling {
+		// a failed poll of the watch mode is retried instead of ending the command
+		panic(errWatchPollFailed)
+	}
+	defer printAndExit(status)
 }
 
-func printAndExit() {
+func printAndExit(status int) {
 
 	if !IsVerbose {
 		fmt.Println("Execute with --verbose to see detailed info.")
 	}
-	os.Exit(1)
+	os.Exit(status)
 }
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportIndexFileName is the manifest written at the root of an export
+const ExportIndexFileName = "index.json"
+
+// ExportSchemaVersion is increased whenever the structure of the export manifest changes incompatibly
+const ExportSchemaVersion = 1
+
+// characters which cannot be used in file names on common file systems
+var unsafeFileNameCharacters = strings.NewReplacer("/", "_", "\\", "_", ":", "_", "*", "_", "?", "_",
+	"\"", "_", "<", "_", ">", "_", "|", "_")
+
+// ExportIndex is the manifest of the configurations exported from a Micro Integrator
+type ExportIndex struct {
+	SchemaVersion int                `json:"schemaVersion"`
+	CreatedAt     string             `json:"createdAt"`
+	Remote        string             `json:"remote"`
+	Url           string             `json:"url"`
+	Artifacts     []ExportedArtifact `json:"artifacts"`
+	Failures      []ExportFailure    `json:"failures"`
+}
+
+// ExportedArtifact is a configuration written to a file of an export
+type ExportedArtifact struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	// File is the path of the configuration relative to the root of the export
+	File   string `json:"file"`
+	Sha256 string `json:"sha256"`
+}
+
+// ExportFailure is an artifact whose configuration could not be exported
+type ExportFailure struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Error string `json:"error"`
+}
+
+// Export the Synapse configurations of the artifacts deployed in a remote to a directory, with a directory per
+// artifact type using the conventional Synapse layout, a file per artifact and an index manifest
+// @param remoteName : name of the remote
+// @param remote : Micro Integrator to export the configurations of
+// @param artifactTypes : types of the artifacts to export, nil for all the types with configurations
+// @param dir : directory to write to, which must not exist or be empty
+// @return manifest of the export, listing the artifacts which could not be exported as failures
+// @return error if the directory cannot be written or the lists of artifacts cannot be fetched
+func ExportArtifactConfigurations(remoteName string, remote Remote, artifactTypes []ArtifactType,
+	dir string) (ExportIndex, error) {
+	if files, err := ioutil.ReadDir(dir); err == nil && len(files) > 0 {
+		return ExportIndex{}, errors.New("directory " + dir + " is not empty")
+	} else if err != nil && !os.IsNotExist(err) {
+		return ExportIndex{}, err
+	}
+	index := ExportIndex{
+		SchemaVersion: ExportSchemaVersion,
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+		Remote:        remoteName,
+		Url:           remote.Url + ":" + remote.Port,
+		Artifacts:     []ExportedArtifact{},
+		Failures:      []ExportFailure{},
+	}
+	results, err := FetchArtifactConfigurations(remote, artifactTypes)
+	if err != nil {
+		return ExportIndex{}, err
+	}
+
+	files := make(map[string]bool)
+	for _, result := range results {
+		if result.Err != nil {
+			index.Failures = append(index.Failures, ExportFailure{Type: result.ArtifactType.Name,
+				Name: result.Name, Error: result.Err.Error()})
+			continue
+		}
+		file := getExportFileName(result.ArtifactType, result.Name, files)
+		files[strings.ToLower(file)] = true
+		content := []byte(result.Configuration)
+		if !strings.HasSuffix(result.Configuration, "\n") {
+			content = append(content, '\n')
+		}
+		filePath := filepath.Join(dir, filepath.FromSlash(file))
+		if err = os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return ExportIndex{}, err
+		}
+		if err = ioutil.WriteFile(filePath, content, 0644); err != nil {
+			return ExportIndex{}, err
+		}
+		index.Artifacts = append(index.Artifacts, ExportedArtifact{Type: result.ArtifactType.Name,
+			Name: result.Name, File: file, Sha256: checksum(content)})
+	}
+
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return ExportIndex{}, err
+	}
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return ExportIndex{}, err
+	}
+	return index, ioutil.WriteFile(filepath.Join(dir, ExportIndexFileName), append(data, '\n'), 0644)
+}
+
+// file of an artifact in the directory named after the resource of its type, which matches the conventional
+// Synapse layout, with a suffix for names only differing in case or in characters unsafe in file names
+func getExportFileName(artifactType ArtifactType, name string, used map[string]bool) string {
+	base := path.Join(artifactType.Resource, unsafeFileNameCharacters.Replace(name))
+	file := base + ".xml"
+	for i := 2; used[strings.ToLower(file)]; i++ {
+		file = base + "_" + strconv.Itoa(i) + ".xml"
+	}
+	return file
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExportArtifactConfigurations(t *testing.T) {
+	server, remote := createRemoteServer(t, map[string]string{
+		PrefixEndpoints: `{"count": 3, "list": [{"name": "StockEP"}, {"name": "stockep"}, {"name": "PayEP"}]}`,
+		PrefixEndpoints + "?endpointName=StockEP": `{"configuration": "<endpoint name=\"StockEP\"/>"}`,
+		PrefixEndpoints + "?endpointName=stockep": `{"configuration": "<endpoint name=\"stockep\"/>"}`,
+		PrefixTemplates: `{"sequenceTemplateList": [{"name": "Log"}], "endpointTemplateList": []}`,
+		PrefixTemplates + "?name=Log&type=sequence": `{"configuration": "<template name=\"Log\"/>"}`,
+	})
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "export")
+	if err != nil {
+		t.Fatal("Error creating the directory: ", err)
+	}
+	defer os.RemoveAll(dir)
+
+	endpointType, _ := GetArtifactType("endpoint")
+	templateType, _ := GetArtifactType("template")
+	index, err := ExportArtifactConfigurations("default", remote, []ArtifactType{endpointType, templateType},
+		filepath.Join(dir, "backup"))
+	if err != nil {
+		t.Fatal("Error exporting the configurations: ", err)
+	}
+	AssertEqual(t, 3, len(index.Artifacts))
+	AssertEqual(t, "endpoints/StockEP.xml", index.Artifacts[0].File)
+	AssertEqual(t, "endpoints/stockep_2.xml", index.Artifacts[1].File)
+	AssertEqual(t, "templates/Log.xml", index.Artifacts[2].File)
+	AssertEqual(t, 1, len(index.Failures))
+	AssertEqual(t, "PayEP", index.Failures[0].Name)
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, "backup", "endpoints", "StockEP.xml"))
+	if err != nil {
+		t.Fatal("Error reading the exported configuration: ", err)
+	}
+	AssertEqual(t, "<endpoint name=\"StockEP\"/>\n", string(content))
+	AssertEqual(t, checksum(content), index.Artifacts[0].Sha256)
+	if _, err = os.Stat(filepath.Join(dir, "backup", ExportIndexFileName)); err != nil {
+		t.Error("Expected the index manifest to be written: ", err)
+	}
+
+	if _, err = ExportArtifactConfigurations("default", remote, []ArtifactType{endpointType},
+		filepath.Join(dir, "backup")); err == nil {
+		t.Error("Expected an error exporting to a directory which is not empty")
+	}
+}
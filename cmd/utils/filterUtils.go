@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+// operators ordered so that two character operators are matched first
+var filterOperators = []string{"!=", ">=", "<=", "!~", "=", "~", ">", "<"}
+
+// RowFilter is a parsed filter expression of the form [field][operator][value]
+type RowFilter struct {
+	Field    string
+	Operator string
+	Value    string
+	column   int
+	pattern  *regexp.Regexp
+	// convert is set when the filter uses the alias of the column
+	convert func(cell string) string
+}
+
+// Parse a filter expression such as name~^order, status=inactive or size>100
+// @param columns : columns of the list the filter is evaluated against
+// @param expression : filter expression
+// @return parsed filter
+// @return error if the expression is invalid or refers to an unknown field
+func ParseRowFilter(columns []artifactUtils.Column, expression string) (RowFilter, error) {
+	position := strings.IndexAny(expression, "=!~<>")
+	if position <= 0 {
+		return RowFilter{}, errors.New("invalid filter '" + expression + "'. Expected [field][operator][value] " +
+			"where operator is one of " + strings.Join(filterOperators, " "))
+	}
+	filter := RowFilter{Field: strings.TrimSpace(expression[:position])}
+	for _, operator := range filterOperators {
+		if strings.HasPrefix(expression[position:], operator) {
+			filter.Operator = operator
+			break
+		}
+	}
+	if filter.Operator == "" {
+		return RowFilter{}, errors.New("invalid operator in filter '" + expression + "'")
+	}
+	filter.Value = strings.TrimSpace(expression[position+len(filter.Operator):])
+
+	filter.column = -1
+	for i, column := range columns {
+		if column.FilterAlias != "" && column.FilterAlias == normalizeColumnName(filter.Field) {
+			filter.column, filter.convert = i, column.FilterAliasValue
+		}
+	}
+	var err error
+	if filter.column < 0 {
+		if filter.column, err = FindColumn(columns, filter.Field); err != nil {
+			return RowFilter{}, errors.New("invalid filter '" + expression + "': " + err.Error())
+		}
+	}
+
+	if filter.Operator == "~" || filter.Operator == "!~" {
+		filter.pattern, err = regexp.Compile("(?i)" + filter.Value)
+		if err != nil {
+			return RowFilter{}, errors.New("invalid regular expression in filter '" + expression + "': " + err.Error())
+		}
+	}
+	return filter, nil
+}
+
+// Matches returns true iff the row satisfies the filter
+func (filter RowFilter) Matches(row artifactUtils.Row) bool {
+	value := cellAt(row, filter.column)
+	if filter.convert != nil {
+		value = filter.convert(value)
+	}
+	switch filter.Operator {
+	case "=":
+		return strings.EqualFold(value, filter.Value)
+	case "!=":
+		return !strings.EqualFold(value, filter.Value)
+	case "~":
+		return filter.pattern.MatchString(value)
+	case "!~":
+		return !filter.pattern.MatchString(value)
+	}
+	comparison := compareValues(value, filter.Value)
+	switch filter.Operator {
+	case ">":
+		return comparison > 0
+	case ">=":
+		return comparison >= 0
+	case "<":
+		return comparison < 0
+	case "<=":
+		return comparison <= 0
+	}
+	return false
+}
+
+// Filter the rows of a list, a row is kept only if it satisfies all the expressions
+// @param columns : columns of the list
+// @param rows : rows of the list
+// @param expressions : filter expressions
+// @return rows satisfying all the filters
+// @return error if an expression is invalid
+func FilterRows(columns []artifactUtils.Column, rows []artifactUtils.Row,
+	expressions []string) ([]artifactUtils.Row, error) {
+
+	if len(expressions) == 0 {
+		return rows, nil
+	}
+	var filters []RowFilter
+	for _, expression := range expressions {
+		filter, err := ParseRowFilter(columns, expression)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	var filtered []artifactUtils.Row
+	for _, row := range rows {
+		matched := true
+		for _, filter := range filters {
+			if !filter.Matches(row) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			filtered = append(filtered, row)
+		}
+	}
+	return filtered, nil
+}
+
+// GetFilterFields returns the field names that can be used in filter expressions of a list
+func GetFilterFields(list artifactUtils.Table) []string {
+	var fields []string
+	for _, column := range list.GetColumns() {
+		fields = append(fields, normalizeColumnName(column.Header))
+		if column.FilterAlias != "" {
+			fields = append(fields, column.FilterAlias)
+		}
+	}
+	return fields
+}
+
+// compare numerically when both values are numbers, otherwise lexically ignoring case
+func compareValues(a, b string) int {
+	x, errX := strconv.ParseFloat(a, 64)
+	y, errY := strconv.ParseFloat(b, 64)
+	if errX == nil && errY == nil {
+		if x < y {
+			return -1
+		} else if x > y {
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
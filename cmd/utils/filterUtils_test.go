@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+func filterMessageProcessors(t *testing.T, expressions ...string) []string {
+	list := &artifactUtils.MessageProcessorList{
+		MessageProcessors: []artifactUtils.MessageProcessor{
+			{Name: "OrderProcessor", Type: "Scheduled-message-forwarding-processor", Status: "active"},
+			{Name: "orderRetry", Type: "Scheduled-message-forwarding-processor", Status: "inactive"},
+			{Name: "AuditSampler", Type: "Message-sampling-processor", Status: "inactive"},
+		},
+	}
+	rows, err := FilterRows(list.GetColumns(), list.GetRows(), expressions)
+	if err != nil {
+		t.Fatal("Error filtering rows: ", err)
+	}
+	var names []string
+	for _, row := range rows {
+		names = append(names, row.Cells[0])
+	}
+	return names
+}
+
+func TestFilterRowsRegex(t *testing.T) {
+	names := filterMessageProcessors(t, "name~^order")
+	AssertEqual(t, 2, len(names))
+	AssertEqual(t, "OrderProcessor", names[0])
+
+	names = filterMessageProcessors(t, "name!~^order")
+	AssertEqual(t, 1, len(names))
+	AssertEqual(t, "AuditSampler", names[0])
+}
+
+func TestFilterRowsEquality(t *testing.T) {
+	names := filterMessageProcessors(t, "status=inactive")
+	AssertEqual(t, 2, len(names))
+
+	names = filterMessageProcessors(t, "status=INACTIVE", "type!=Message-sampling-processor")
+	AssertEqual(t, 1, len(names))
+	AssertEqual(t, "orderRetry", names[0])
+}
+
+func TestFilterRowsNumeric(t *testing.T) {
+	list := &artifactUtils.MessageStoreList{
+		MessageStores: []artifactUtils.MessageStore{
+			{Name: "A", Size: 9}, {Name: "B", Size: 100}, {Name: "C", Size: 250},
+		},
+	}
+	rows, err := FilterRows(list.GetColumns(), list.GetRows(), []string{"size>=100"})
+	if err != nil {
+		t.Fatal("Error filtering rows: ", err)
+	}
+	AssertEqual(t, 2, len(rows))
+	AssertEqual(t, "B", rows[0].Cells[0])
+
+	rows, _ = FilterRows(list.GetColumns(), list.GetRows(), []string{"size<100"})
+	AssertEqual(t, 1, len(rows))
+	AssertEqual(t, "A", rows[0].Cells[0])
+}
+
+func TestParseRowFilterInvalid(t *testing.T) {
+	columns := (&artifactUtils.APIList{}).GetColumns()
+	for _, expression := range []string{"name", "=value", "context=/order", "name~[order"} {
+		if _, err := ParseRowFilter(columns, expression); err == nil {
+			t.Errorf("Expected an error for filter '%s'\n", expression)
+		}
+	}
+}
+
+func TestFilterRowsEndpointStatus(t *testing.T) {
+	list := &artifactUtils.EndpointList{Endpoints: []artifactUtils.EndpointSummary{
+		{Name: "OrderEP", Type: "http", Active: true}, {Name: "StockEP", Type: "address", Active: false}}}
+	rows, err := FilterRows(list.GetColumns(), list.GetRows(), []string{"status=inactive"})
+	if err != nil {
+		t.Fatal("Error filtering rows: ", err)
+	}
+	AssertEqual(t, 1, len(rows))
+	AssertEqual(t, "StockEP", rows[0].Cells[0])
+
+	rows, _ = FilterRows(list.GetColumns(), list.GetRows(), []string{"status!=inactive", "active=true"})
+	AssertEqual(t, 1, len(rows))
+	AssertEqual(t, "OrderEP", rows[0].Cells[0])
+	AssertEqual(t, "name, type, active, status", strings.Join(GetFilterFields(list), ", "))
+}
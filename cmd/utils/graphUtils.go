@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DependencyGraph holds the artifacts of a deployment and the artifacts each of them uses
+type DependencyGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// GraphNode is an artifact of a dependency graph
+type GraphNode struct {
+	// Id is the kind and the name of the artifact, such as endpoint/StockEP
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// Defined is false for artifacts which are used but not defined in the deployment
+	Defined bool `json:"defined"`
+}
+
+// GraphEdge tells that an artifact uses another artifact
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// shapes of the artifacts of each kind in Graphviz DOT form
+var graphNodeShapes = map[string]string{
+	SynapseKindAPI:              "box",
+	SynapseKindProxy:            "box",
+	SynapseKindInboundEndpoint:  "box",
+	SynapseKindTask:             "box",
+	SynapseKindSequence:         "ellipse",
+	SynapseKindTemplate:         "ellipse",
+	SynapseKindLocalEntry:       "note",
+	SynapseKindEndpoint:         "octagon",
+	SynapseKindMessageStore:     "cylinder",
+	SynapseKindMessageProcessor: "component",
+}
+
+// Build the graph of the artifacts of a deployment and the sequences, endpoints, templates, message stores and
+// proxy services each of them uses
+// @param configs : configurations of the artifacts of the deployment
+// @return graph with nodes and edges ordered by id
+func BuildDependencyGraph(configs []SynapseConfig) DependencyGraph {
+	definitions := make(map[string]map[string]SynapseConfig)
+	nodes := make(map[string]GraphNode)
+	for _, config := range configs {
+		if definitions[config.Kind] == nil {
+			definitions[config.Kind] = make(map[string]SynapseConfig)
+		}
+		definitions[config.Kind][config.Name] = config
+		node := newGraphNode(config.Kind, config.Name, true)
+		nodes[node.Id] = node
+	}
+
+	edges := make(map[GraphEdge]bool)
+	for _, config := range configs {
+		from := getGraphNodeId(config.Kind, config.Name)
+		for _, reference := range config.References() {
+			kind := reference.Kind
+			if _, found := definitions[kind][reference.Key]; !found {
+				// sequences, endpoints and templates are also looked up in the local entries
+				_, found = definitions[SynapseKindLocalEntry][reference.Key]
+				if found && kind != SynapseKindMessageStore && kind != SynapseKindProxy {
+					kind = SynapseKindLocalEntry
+				}
+			}
+			to := getGraphNodeId(kind, reference.Key)
+			if _, found := nodes[to]; !found {
+				defined := kind == SynapseKindSequence && ContainsString(builtInSequences, reference.Key)
+				nodes[to] = newGraphNode(kind, reference.Key, defined)
+			}
+			if to != from {
+				edges[GraphEdge{From: from, To: to}] = true
+			}
+		}
+	}
+
+	graph := DependencyGraph{Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+	for _, node := range nodes {
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool { return graph.Nodes[i].Id < graph.Nodes[j].Id })
+	for edge := range edges {
+		graph.Edges = append(graph.Edges, edge)
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		return graph.Edges[i].To < graph.Edges[j].To
+	})
+	return graph
+}
+
+func newGraphNode(kind, name string, defined bool) GraphNode {
+	return GraphNode{Id: getGraphNodeId(kind, name), Kind: kind, Name: name, Defined: defined}
+}
+
+func getGraphNodeId(kind, name string) string {
+	return kind + "/" + name
+}
+
+// FindNode finds an artifact of the graph by its id such as endpoint/StockEP, or by its name if no other kind of
+// artifact has the same name
+func (graph DependencyGraph) FindNode(artifact string) (GraphNode, error) {
+	var matches []GraphNode
+	for _, node := range graph.Nodes {
+		if node.Id == artifact {
+			return node, nil
+		}
+		if node.Name == artifact {
+			matches = append(matches, node)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+	if len(matches) == 0 {
+		return GraphNode{}, errors.New("no artifact named " + artifact + " in the dependency graph")
+	}
+	var ids []string
+	for _, node := range matches {
+		ids = append(ids, node.Id)
+	}
+	return GraphNode{}, errors.New(artifact + " is ambiguous, use one of " + strings.Join(ids, ", "))
+}
+
+// Dependents returns the artifacts which use an artifact directly or through other artifacts, such as the APIs
+// calling a sequence which sends to an endpoint
+// @param id : id of the artifact
+// @return artifacts ordered by id
+func (graph DependencyGraph) Dependents(id string) []GraphNode {
+	users := make(map[string][]string)
+	for _, edge := range graph.Edges {
+		users[edge.To] = append(users[edge.To], edge.From)
+	}
+	return graph.reachable(id, users)
+}
+
+// Dependencies returns the artifacts which an artifact uses directly or through other artifacts, such as the
+// sequences and endpoints called by an API
+// @param id : id of the artifact
+// @return artifacts ordered by id
+func (graph DependencyGraph) Dependencies(id string) []GraphNode {
+	uses := make(map[string][]string)
+	for _, edge := range graph.Edges {
+		uses[edge.From] = append(uses[edge.From], edge.To)
+	}
+	return graph.reachable(id, uses)
+}
+
+// find the artifacts reachable from an artifact by following the given links, excluding the artifact itself
+func (graph DependencyGraph) reachable(id string, links map[string][]string) []GraphNode {
+	visited := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		for _, next := range links[queue[0]] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+		queue = queue[1:]
+	}
+	nodes := []GraphNode{}
+	for _, node := range graph.Nodes {
+		if visited[node.Id] && node.Id != id {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// DOT writes the graph in Graphviz DOT form, with artifacts which are not defined drawn dashed
+func (graph DependencyGraph) DOT() string {
+	var builder strings.Builder
+	builder.WriteString("digraph dependencies {\n  rankdir=LR;\n")
+	for _, node := range graph.Nodes {
+		attributes := "label=" + strconv.Quote(node.Name+"\n"+node.Kind)
+		if shape, found := graphNodeShapes[node.Kind]; found {
+			attributes += ", shape=" + shape
+		}
+		if !node.Defined {
+			attributes += ", style=dashed"
+		}
+		builder.WriteString("  " + strconv.Quote(node.Id) + " [" + attributes + "];\n")
+	}
+	for _, edge := range graph.Edges {
+		builder.WriteString("  " + strconv.Quote(edge.From) + " -> " + strconv.Quote(edge.To) + ";\n")
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+// Mermaid writes the graph as a Mermaid flowchart, with artifacts which are not defined drawn dashed
+func (graph DependencyGraph) Mermaid() string {
+	var builder strings.Builder
+	builder.WriteString("flowchart LR\n")
+	// artifact names may hold characters which are not allowed in Mermaid ids
+	ids := make(map[string]string)
+	var undefined []string
+	for i, node := range graph.Nodes {
+		ids[node.Id] = "n" + strconv.Itoa(i)
+		label := strings.Replace(node.Name, "\"", "#quot;", -1) + "<br/>" + node.Kind
+		builder.WriteString("  " + ids[node.Id] + "[\"" + label + "\"]\n")
+		if !node.Defined {
+			undefined = append(undefined, ids[node.Id])
+		}
+	}
+	for _, edge := range graph.Edges {
+		builder.WriteString("  " + ids[edge.From] + " --> " + ids[edge.To] + "\n")
+	}
+	if len(undefined) > 0 {
+		builder.WriteString("  classDef undefined stroke-dasharray: 5 5\n")
+		builder.WriteString("  class " + strings.Join(undefined, ",") + " undefined\n")
+	}
+	return builder.String()
+}
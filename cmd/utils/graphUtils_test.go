@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func buildDirectoryGraph(t *testing.T, files map[string]string) DependencyGraph {
+	dir := createSynapseDirectory(t, files)
+	defer os.RemoveAll(dir)
+	configs, _, err := LoadSynapseConfigs(dir)
+	if err != nil {
+		t.Fatal("Error loading the configurations: ", err)
+	}
+	return BuildDependencyGraph(configs)
+}
+
+var graphTestFiles = map[string]string{
+	"apis/OrderAPI.xml":      `<api name="OrderAPI" context="/order"><resource inSequence="OrderSeq"/></api>`,
+	"sequences/OrderSeq.xml": `<sequence name="OrderSeq"><call><endpoint key="StockEP"/></call></sequence>`,
+	"endpoints/StockEP.xml":  `<endpoint name="StockEP"><address uri="http://stock"/></endpoint>`,
+	"proxy-services/StockProxy.xml": `<proxy name="StockProxy"><target endpoint="StockEP"/>` +
+		`</proxy>`,
+	"inbound-endpoints/OrderFiles.xml": `<inboundEndpoint name="OrderFiles" sequence="OrderSeq"/>`,
+	"message-processors/OrderProc.xml": `<messageProcessor name="OrderProc" messageStore="OrderStore"/>`,
+	"local-entries/Order.xml":          `<localEntry key="Order">order</localEntry>`,
+	"templates/Order.xml":              `<template name="Order"><sequence/></template>`,
+	"sequences/Audit.xml":              `<sequence name="Audit"><call-template target="Order"/></sequence>`,
+}
+
+func TestBuildDependencyGraph(t *testing.T) {
+	graph := buildDirectoryGraph(t, graphTestFiles)
+	var edges []string
+	for _, edge := range graph.Edges {
+		edges = append(edges, edge.From+" -> "+edge.To)
+	}
+	AssertEqual(t, "api/OrderAPI -> sequence/OrderSeq, inboundEndpoint/OrderFiles -> sequence/OrderSeq, "+
+		"messageProcessor/OrderProc -> messageStore/OrderStore, proxy/StockProxy -> endpoint/StockEP, "+
+		"sequence/Audit -> template/Order, sequence/OrderSeq -> endpoint/StockEP", strings.Join(edges, ", "))
+
+	store, err := graph.FindNode("OrderStore")
+	if err != nil {
+		t.Fatal("Error finding the message store: ", err)
+	}
+	AssertEqual(t, false, store.Defined)
+	if _, err = graph.FindNode("Order"); err == nil {
+		t.Error("Expected an error for a name used by several kinds of artifacts")
+	}
+}
+
+func TestDependents(t *testing.T) {
+	graph := buildDirectoryGraph(t, graphTestFiles)
+	var ids []string
+	for _, node := range graph.Dependents("endpoint/StockEP") {
+		ids = append(ids, node.Id)
+	}
+	AssertEqual(t, "api/OrderAPI, inboundEndpoint/OrderFiles, proxy/StockProxy, sequence/OrderSeq",
+		strings.Join(ids, ", "))
+	AssertEqual(t, 0, len(graph.Dependents("api/OrderAPI")))
+}
+
+func TestGraphExport(t *testing.T) {
+	graph := buildDirectoryGraph(t, map[string]string{
+		"message-processors/OrderProc.xml": `<messageProcessor name="OrderProc" messageStore="OrderStore"/>`,
+	})
+	AssertEqual(t, "digraph dependencies {\n  rankdir=LR;\n"+
+		"  \"messageProcessor/OrderProc\" [label=\"OrderProc\\nmessageProcessor\", shape=component];\n"+
+		"  \"messageStore/OrderStore\" [label=\"OrderStore\\nmessageStore\", shape=cylinder, style=dashed];\n"+
+		"  \"messageProcessor/OrderProc\" -> \"messageStore/OrderStore\";\n}\n", graph.DOT())
+	AssertEqual(t, "flowchart LR\n  n0[\"OrderProc<br/>messageProcessor\"]\n"+
+		"  n1[\"OrderStore<br/>messageStore\"]\n  n0 --> n1\n"+
+		"  classDef undefined stroke-dasharray: 5 5\n  class n1 undefined\n", graph.Mermaid())
+}
+
+func TestDependencies(t *testing.T) {
+	graph := buildDirectoryGraph(t, graphTestFiles)
+	var ids []string
+	for _, node := range graph.Dependencies("api/OrderAPI") {
+		ids = append(ids, node.Id)
+	}
+	AssertEqual(t, "endpoint/StockEP, sequence/OrderSeq", strings.Join(ids, ", "))
+	AssertEqual(t, 0, len(graph.Dependencies("endpoint/StockEP")))
+}
@@ -0,0 +1,364 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+// InventorySchemaVersion is increased whenever the structure of a saved inventory changes incompatibly
+const InventorySchemaVersion = 1
+
+const InventoryChangeAdded = "added"
+const InventoryChangeRemoved = "removed"
+const InventoryChangeChanged = "changed"
+
+// InventorySectionLogLevel is the section holding the logger levels of an inventory
+const InventorySectionLogLevel = "log-level"
+
+// maximum number of artifact details fetched concurrently from a remote
+const maxConcurrentDetailRequests = 8
+
+// Inventory is the state of all the artifacts deployed in a Micro Integrator at a point in time
+type Inventory struct {
+	SchemaVersion int                        `json:"schemaVersion"`
+	CreatedAt     string                     `json:"createdAt"`
+	Remote        string                     `json:"remote"`
+	Url           string                     `json:"url"`
+	Artifacts     map[string][]InventoryItem `json:"artifacts"`
+	LogLevels     map[string]string          `json:"logLevels"`
+}
+
+// InventoryItem is a single artifact with its versions, states and flags keyed by column name
+type InventoryItem struct {
+	Name       string            `json:"name"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// InventoryChange is a difference between two inventories
+type InventoryChange struct {
+	Kind     string `json:"kind"`
+	Section  string `json:"section"`
+	Name     string `json:"name"`
+	Property string `json:"property,omitempty"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new,omitempty"`
+}
+
+// Fetch the inventory of a remote, including the details missing in the artifact summaries and the log levels
+// @param remoteName : name of the remote
+// @param remote : Micro Integrator to fetch the inventory from
+// @return inventory of the remote
+// @return error if any part of the inventory could not be fetched
+func FetchInventory(remoteName string, remote Remote) (Inventory, error) {
+	inventory := Inventory{
+		SchemaVersion: InventorySchemaVersion,
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+		Remote:        remoteName,
+		Url:           remote.Url + ":" + remote.Port,
+		Artifacts:     make(map[string][]InventoryItem),
+		LogLevels:     make(map[string]string),
+	}
+	for _, result := range FetchArtifactLists(remote, ArtifactTypes) {
+		if result.Err != nil {
+			return Inventory{}, errors.New("fetching " + result.ArtifactType.Name + " list failed: " +
+				result.Err.Error())
+		}
+		items := getInventoryItems(result.List)
+		if result.ArtifactType.NewDetail != nil {
+			if err := fetchInventoryDetails(remote, result.ArtifactType, items); err != nil {
+				return Inventory{}, err
+			}
+		}
+		inventory.Artifacts[result.ArtifactType.Name] = items
+	}
+
+	loggers := &LoggerList{}
+	if err := FetchRemoteData(remote, PrefixLogging, nil, loggers); err != nil {
+		return Inventory{}, errors.New("fetching log levels failed: " + err.Error())
+	}
+	for _, logger := range loggers.Loggers {
+		inventory.LogLevels[logger.LoggerName] = logger.LogLevel
+	}
+	return inventory, nil
+}
+
+// Fetch the inventories of several remotes concurrently
+// @param remoteNames : names of the remotes in the remote configuration
+// @return inventories in the order of the given names
+// @return errors in the order of the given names, nil for each inventory fetched successfully
+func FetchInventories(remoteNames []string) ([]Inventory, []error) {
+	inventories := make([]Inventory, len(remoteNames))
+	errs := make([]error, len(remoteNames))
+	var waitGroup sync.WaitGroup
+	for i, remoteName := range remoteNames {
+		waitGroup.Add(1)
+		go func(i int, remoteName string) {
+			defer waitGroup.Done()
+			inventories[i], errs[i] = FetchInventory(remoteName, RemoteConfigData.Remotes[remoteName])
+		}(i, remoteName)
+	}
+	waitGroup.Wait()
+	return inventories, errs
+}
+
+// convert the rows of a list to inventory items, leaving out the volatile columns
+func getInventoryItems(list artifactUtils.Table) []InventoryItem {
+	columns := list.GetColumns()
+	items := make([]InventoryItem, 0, len(list.GetRows()))
+	for _, row := range list.GetRows() {
+		item := InventoryItem{Name: cellAt(row, 0), Properties: make(map[string]string)}
+		for i := 1; i < len(columns); i++ {
+			if !columns[i].Volatile {
+				item.Properties[normalizeColumnName(columns[i].Header)] = cellAt(row, i)
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// add the properties of the detailed view of each artifact to its inventory item
+func fetchInventoryDetails(remote Remote, artifactType ArtifactType, items []InventoryItem) error {
+	errs := make([]error, len(items))
+	semaphore := make(chan struct{}, maxConcurrentDetailRequests)
+	var waitGroup sync.WaitGroup
+	for i := range items {
+		waitGroup.Add(1)
+		go func(item *InventoryItem, err *error) {
+			defer waitGroup.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			detail := artifactType.NewDetail()
+			params := map[string]string{artifactType.DetailParam: item.Name}
+			if *err = FetchRemoteData(remote, artifactType.Resource, params, detail); *err != nil {
+				return
+			}
+			for key, value := range detail.GetProperties() {
+				item.Properties[key] = value
+			}
+		}(&items[i], &errs[i])
+	}
+	waitGroup.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return errors.New("fetching " + artifactType.Name + " " + items[i].Name + " failed: " + err.Error())
+		}
+	}
+	return nil
+}
+
+// Read an inventory saved to a file
+// @param path : path of the file
+// @return inventory
+// @return error if the file cannot be read or is not a supported inventory
+func ReadInventory(path string) (Inventory, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Inventory{}, err
+	}
+	var inventory Inventory
+	if err = json.Unmarshal(data, &inventory); err != nil {
+		return Inventory{}, errors.New(path + " is not a valid snapshot: " + err.Error())
+	}
+	if inventory.SchemaVersion == 0 {
+		return Inventory{}, errors.New(path + " is not a valid snapshot: schema version is missing")
+	}
+	if inventory.SchemaVersion > InventorySchemaVersion {
+		return Inventory{}, errors.New(path + " uses snapshot schema version " +
+			strconv.Itoa(inventory.SchemaVersion) + " which is newer than the supported version " +
+			strconv.Itoa(InventorySchemaVersion))
+	}
+	return inventory, nil
+}
+
+// Write an inventory to a file as indented JSON
+// @param path : path of the file
+// @param inventory : inventory to write
+// @return error if the file cannot be written
+func WriteInventory(path string, inventory Inventory) error {
+	data, err := json.MarshalIndent(inventory, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Find the artifacts and log levels which were added, removed or changed between two inventories
+// @param old : inventory at the earlier point in time
+// @param new : inventory at the later point in time
+// @return changes ordered by artifact type and name
+func DiffInventories(old, new Inventory) []InventoryChange {
+	return diffInventories(old, new, false)
+}
+
+// Find the differences of the inventory of a node from the inventory of another node of the same deployment,
+// ignoring the properties which depend on the node such as URLs
+// @param baseline : inventory of the node to compare with
+// @param node : inventory of the compared node
+// @return changes ordered by artifact type and name
+func DiffNodeInventories(baseline, node Inventory) []InventoryChange {
+	return diffInventories(baseline, node, true)
+}
+
+func diffInventories(old, new Inventory, ignoreNodeSpecific bool) []InventoryChange {
+	var changes []InventoryChange
+	for _, section := range getInventorySections(old, new) {
+		var ignored []string
+		if ignoreNodeSpecific {
+			ignored = getNodeSpecificProperties(section)
+		}
+		oldItems := getInventoryItemMap(section, old.Artifacts[section])
+		newItems := getInventoryItemMap(section, new.Artifacts[section])
+		for _, name := range getSortedNames(oldItems, newItems) {
+			oldItem, inOld := oldItems[name]
+			newItem, inNew := newItems[name]
+			if !inNew {
+				changes = append(changes, InventoryChange{Kind: InventoryChangeRemoved, Section: section, Name: name})
+			} else if !inOld {
+				changes = append(changes, InventoryChange{Kind: InventoryChangeAdded, Section: section, Name: name})
+			} else {
+				changes = append(changes, diffProperties(section, name, oldItem.Properties, newItem.Properties,
+					ignored)...)
+			}
+		}
+	}
+	for _, name := range getSortedKeys(old.LogLevels, new.LogLevels) {
+		oldLevel, inOld := old.LogLevels[name]
+		newLevel, inNew := new.LogLevels[name]
+		change := InventoryChange{Section: InventorySectionLogLevel, Name: name, Old: oldLevel, New: newLevel}
+		if !inNew {
+			change.Kind = InventoryChangeRemoved
+		} else if !inOld {
+			change.Kind = InventoryChangeAdded
+		} else if oldLevel != newLevel {
+			change.Kind = InventoryChangeChanged
+			change.Property = "level"
+		} else {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func diffProperties(section, name string, old, new map[string]string, ignored []string) []InventoryChange {
+	var changes []InventoryChange
+	for _, property := range getSortedKeys(old, new) {
+		if old[property] != new[property] && !ContainsString(ignored, property) {
+			changes = append(changes, InventoryChange{Kind: InventoryChangeChanged, Section: section, Name: name,
+				Property: property, Old: old[property], New: new[property]})
+		}
+	}
+	return changes
+}
+
+// properties of the given artifact type which depend on the node
+func getNodeSpecificProperties(section string) []string {
+	artifactType, err := GetArtifactType(section)
+	if err != nil {
+		return nil
+	}
+	var properties []string
+	for _, column := range artifactType.NewList().GetColumns() {
+		if column.NodeSpecific {
+			properties = append(properties, normalizeColumnName(column.Header))
+		}
+	}
+	return properties
+}
+
+// artifact types present in either inventory, known types first in the order of the registry
+func getInventorySections(inventories ...Inventory) []string {
+	sections := GetArtifactTypeNames()
+	var unknown []string
+	for _, inventory := range inventories {
+		for section := range inventory.Artifacts {
+			if !ContainsString(sections, section) && !ContainsString(unknown, section) {
+				unknown = append(unknown, section)
+			}
+		}
+	}
+	sort.Strings(unknown)
+	return append(sections, unknown...)
+}
+
+// sections listing artifacts of several types, in which the same name can be used once for each type
+var typedInventorySections = map[string]bool{"template": true}
+
+// map the items of a section by name, or by type and name in the sections holding several types
+func getInventoryItemMap(section string, items []InventoryItem) map[string]InventoryItem {
+	itemMap := make(map[string]InventoryItem)
+	for _, item := range items {
+		if typedInventorySections[section] {
+			itemMap[strings.ToLower(item.Properties["type"])+"/"+item.Name] = item
+		} else {
+			itemMap[item.Name] = item
+		}
+	}
+	return itemMap
+}
+
+// sorted union of the names of two sets of items
+func getSortedNames(first, second map[string]InventoryItem) []string {
+	names := make(map[string]string)
+	for name := range first {
+		names[name] = name
+	}
+	for name := range second {
+		names[name] = name
+	}
+	return getSortedKeys(names, nil)
+}
+
+// sorted union of the keys of two maps
+func getSortedKeys(first, second map[string]string) []string {
+	var keys []string
+	for key := range first {
+		keys = append(keys, key)
+	}
+	for key := range second {
+		if _, found := first[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// String describes a change in a single line such as "~ compositeapp/OrderApp version: 1.0.0 -> 1.0.1"
+func (change InventoryChange) String() string {
+	item := change.Section + "/" + change.Name
+	switch change.Kind {
+	case InventoryChangeAdded:
+		return "+ " + item
+	case InventoryChangeRemoved:
+		return "- " + item
+	}
+	return "~ " + item + " " + change.Property + ": " + change.Old + " -> " + change.New
+}
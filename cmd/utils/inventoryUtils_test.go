@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+func createInventory(capps []artifactUtils.CompositeAppSummary, logLevel string) Inventory {
+	list := &artifactUtils.CompositeAppList{ActiveCompositeApps: capps}
+	return Inventory{
+		SchemaVersion: InventorySchemaVersion,
+		Artifacts:     map[string][]InventoryItem{"compositeapp": getInventoryItems(list)},
+		LogLevels:     map[string]string{"root": logLevel},
+	}
+}
+
+func TestDiffInventories(t *testing.T) {
+	old := createInventory([]artifactUtils.CompositeAppSummary{
+		{Name: "OrderApp", Version: "1.0.0"}, {Name: "AuditApp", Version: "1.0.0"}}, "INFO")
+	new := createInventory([]artifactUtils.CompositeAppSummary{
+		{Name: "OrderApp", Version: "1.0.1"}, {Name: "StockApp", Version: "2.0.0"}}, "DEBUG")
+
+	changes := DiffInventories(old, new)
+	AssertEqual(t, 4, len(changes))
+	AssertEqual(t, "- compositeapp/AuditApp", changes[0].String())
+	AssertEqual(t, "~ compositeapp/OrderApp version: 1.0.0 -> 1.0.1", changes[1].String())
+	AssertEqual(t, "+ compositeapp/StockApp", changes[2].String())
+	AssertEqual(t, "~ log-level/root level: INFO -> DEBUG", changes[3].String())
+
+	AssertEqual(t, 0, len(DiffInventories(old, old)))
+}
+
+func TestInventoryLeavesOutVolatileColumns(t *testing.T) {
+	list := &artifactUtils.MessageStoreList{
+		MessageStores: []artifactUtils.MessageStore{{Name: "OrderStore", Type: "jms-message-store", Size: 12}},
+	}
+	items := getInventoryItems(list)
+	AssertEqual(t, "jms-message-store", items[0].Properties["type"])
+	if _, found := items[0].Properties["size"]; found {
+		t.Error("Volatile column should be left out of the inventory")
+	}
+}
+
+func TestReadInventory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inventory")
+	if err != nil {
+		t.Fatal("Error creating a temporary directory: ", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "snapshot.json")
+	inventory := createInventory([]artifactUtils.CompositeAppSummary{{Name: "OrderApp", Version: "1.0.0"}}, "INFO")
+	if err = WriteInventory(path, inventory); err != nil {
+		t.Fatal("Error writing the inventory: ", err)
+	}
+	read, err := ReadInventory(path)
+	if err != nil {
+		t.Fatal("Error reading the inventory: ", err)
+	}
+	AssertEqual(t, 0, len(DiffInventories(inventory, read)))
+
+	newer := filepath.Join(dir, "newer.json")
+	ioutil.WriteFile(newer, []byte(`{"schemaVersion": 99}`), 0644)
+	if _, err = ReadInventory(newer); err == nil {
+		t.Error("Expected an error for a newer schema version")
+	}
+}
+
+func TestDiffNodeInventoriesIgnoresUrls(t *testing.T) {
+	node := func(host, version string) Inventory {
+		list := &artifactUtils.APIList{Apis: []artifactUtils.APISummary{{Name: "OrderAPI", Url: host + "/order"}}}
+		items := getInventoryItems(list)
+		api := &artifactUtils.API{Url: host + "/order", Version: version}
+		for key, value := range api.GetProperties() {
+			items[0].Properties[key] = value
+		}
+		return Inventory{SchemaVersion: InventorySchemaVersion, Artifacts: map[string][]InventoryItem{"api": items}}
+	}
+
+	AssertEqual(t, 0, len(DiffNodeInventories(node("http://node1:8290", "1.0"), node("http://node2:8290", "1.0"))))
+	AssertEqual(t, 1, len(DiffInventories(node("http://node1:8290", "1.0"), node("http://node2:8290", "1.0"))))
+
+	changes := DiffNodeInventories(node("http://node1:8290", "1.0"), node("http://node2:8290", "2.0"))
+	AssertEqual(t, 1, len(changes))
+	AssertEqual(t, "~ api/OrderAPI version: 1.0 -> 2.0", changes[0].String())
+}
+
+func TestDiffInventoriesTemplatesOfSameName(t *testing.T) {
+	templates := func(names ...string) Inventory {
+		list := &artifactUtils.TemplateList{}
+		for _, name := range names {
+			list.SequenceTemplates = append(list.SequenceTemplates, artifactUtils.Template{Name: name})
+		}
+		list.EndpointTemplates = append(list.EndpointTemplates, artifactUtils.Template{Name: "Retry"})
+		return Inventory{SchemaVersion: InventorySchemaVersion,
+			Artifacts: map[string][]InventoryItem{"template": getInventoryItems(list)}}
+	}
+
+	AssertEqual(t, 0, len(DiffInventories(templates("Retry"), templates("Retry"))))
+	changes := DiffInventories(templates("Retry"), templates())
+	AssertEqual(t, 1, len(changes))
+	AssertEqual(t, "- template/sequence/Retry", changes[0].String())
+}
+
+func TestDiffInventoriesProxyState(t *testing.T) {
+	proxy := func(running bool) Inventory {
+		list := &artifactUtils.ProxyServiceList{Proxies: []artifactUtils.ProxySummary{{Name: "StockQuoteProxy"}}}
+		items := getInventoryItems(list)
+		detail := &artifactUtils.Proxy{IsRunning: &running}
+		for key, value := range detail.GetProperties() {
+			items[0].Properties[key] = value
+		}
+		return Inventory{SchemaVersion: InventorySchemaVersion,
+			Artifacts: map[string][]InventoryItem{"proxyservice": items}}
+	}
+
+	changes := DiffInventories(proxy(true), proxy(false))
+	AssertEqual(t, 1, len(changes))
+	AssertEqual(t, "~ proxyservice/StockQuoteProxy state: active -> inactive", changes[0].String())
+}
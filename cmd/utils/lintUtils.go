@@ -0,0 +1,377 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LintRule is a problem found by the linter in Synapse configurations
+type LintRule struct {
+	Id          string
+	Description string
+}
+
+const LintRuleInvalidConfiguration = "invalid-configuration"
+const LintRuleDuplicateName = "duplicate-name"
+const LintRuleUndefinedSequence = "undefined-sequence"
+const LintRuleUndefinedEndpoint = "undefined-endpoint"
+const LintRuleUndefinedTemplate = "undefined-template"
+const LintRuleUndefinedMessageStore = "undefined-message-store"
+const LintRuleUndefinedProxy = "undefined-proxy-service"
+const LintRuleOverlappingAPI = "overlapping-api"
+const LintRuleProxyWithoutTarget = "proxy-without-target"
+const LintRuleTaskMissingProperty = "task-missing-property"
+
+var LintRules = []LintRule{
+	{LintRuleInvalidConfiguration, "Configuration is not well formed, is not of the type of its directory or has no name"},
+	{LintRuleDuplicateName, "Two artifacts of the same kind, or two sequences, endpoints, local entries or " +
+		"templates, have the same name"},
+	{LintRuleUndefinedSequence, "Referenced sequence is not defined"},
+	{LintRuleUndefinedEndpoint, "Referenced endpoint is not defined"},
+	{LintRuleUndefinedTemplate, "Referenced template is not defined"},
+	{LintRuleUndefinedMessageStore, "Referenced message store is not defined"},
+	{LintRuleUndefinedProxy, "Referenced proxy service is not defined"},
+	{LintRuleOverlappingAPI, "Two APIs have the same context and version"},
+	{LintRuleProxyWithoutTarget, "Proxy service has no target to mediate messages with"},
+	{LintRuleTaskMissingProperty, "Task has no class or trigger, or misses a property required by its class"},
+}
+
+// rule reporting an undefined reference to an artifact of each kind
+var undefinedReferenceRules = map[string]string{
+	SynapseKindSequence:     LintRuleUndefinedSequence,
+	SynapseKindEndpoint:     LintRuleUndefinedEndpoint,
+	SynapseKindTemplate:     LintRuleUndefinedTemplate,
+	SynapseKindMessageStore: LintRuleUndefinedMessageStore,
+	SynapseKindProxy:        LintRuleUndefinedProxy,
+}
+
+// names of the kinds of artifacts used in diagnostics
+var synapseKindNames = map[string]string{
+	SynapseKindAPI:              "API",
+	SynapseKindSequence:         "sequence",
+	SynapseKindEndpoint:         "endpoint",
+	SynapseKindProxy:            "proxy service",
+	SynapseKindLocalEntry:       "local entry",
+	SynapseKindTask:             "task",
+	SynapseKindTemplate:         "template",
+	SynapseKindMessageStore:     "message store",
+	SynapseKindMessageProcessor: "message processor",
+	SynapseKindInboundEndpoint:  "inbound endpoint",
+}
+
+// properties required by the task classes shipped with the Micro Integrator, where each group is satisfied by
+// any one of its properties
+var requiredTaskProperties = map[string][][]string{
+	"org.apache.synapse.startup.tasks.MessageInjector": {{"message", "registryKey"}},
+}
+
+// version of the SARIF format of the static analysis results written by the linter
+const sarifVersion = "2.1.0"
+const sarifSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+// SarifLog is a SARIF document holding the diagnostics of a lint run, readable by code scanning tools
+type SarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	Id               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleId    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	Uri string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// LintDiagnostic is a problem found at a position of a configuration
+type LintDiagnostic struct {
+	Rule    string `json:"rule"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// Check Synapse configurations for broken references and conflicts which would make their deployment fail
+// @param configs : configurations of the artifacts checked together
+// @param fileErrors : configurations which could not be parsed
+// @return diagnostics ordered by file and position
+func LintSynapseConfigs(configs []SynapseConfig, fileErrors []SynapseFileError) []LintDiagnostic {
+	var diagnostics []LintDiagnostic
+	for _, fileError := range fileErrors {
+		diagnostics = append(diagnostics, LintDiagnostic{Rule: LintRuleInvalidConfiguration, File: fileError.File,
+			Line: fileError.Line, Column: fileError.Column, Message: fileError.Message})
+	}
+
+	definitions := make(map[string]map[string]SynapseConfig)
+	keys := make(map[string]SynapseConfig)
+	for _, config := range configs {
+		key := getSynapseKeyspace(config.Kind) + "/" + config.Name
+		if first, found := keys[key]; found {
+			diagnostics = append(diagnostics, newConfigDiagnostic(config, LintRuleDuplicateName,
+				describeConfig(config)+" is already defined as "+describeConfig(first)+" at "+
+					getConfigPosition(first)))
+		} else {
+			keys[key] = config
+		}
+		if definitions[config.Kind] == nil {
+			definitions[config.Kind] = make(map[string]SynapseConfig)
+		}
+		if _, found := definitions[config.Kind][config.Name]; !found {
+			definitions[config.Kind][config.Name] = config
+		}
+	}
+
+	for _, config := range configs {
+		for _, reference := range config.References() {
+			if !isSynapseKeyDefined(definitions, reference) {
+				diagnostics = append(diagnostics, LintDiagnostic{Rule: undefinedReferenceRules[reference.Kind],
+					File: config.File, Line: reference.Line, Column: reference.Column,
+					Message: synapseKindNames[reference.Kind] + " '" + reference.Key + "' used by " +
+						describeConfig(config) + " is not defined"})
+			}
+		}
+		switch config.Kind {
+		case SynapseKindProxy:
+			diagnostics = append(diagnostics, lintProxy(config)...)
+		case SynapseKindTask:
+			diagnostics = append(diagnostics, lintTask(config)...)
+		}
+	}
+	diagnostics = append(diagnostics, lintAPIContexts(configs)...)
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		if diagnostics[i].File != diagnostics[j].File {
+			return diagnostics[i].File < diagnostics[j].File
+		}
+		if diagnostics[i].Line != diagnostics[j].Line {
+			return diagnostics[i].Line < diagnostics[j].Line
+		}
+		return diagnostics[i].Column < diagnostics[j].Column
+	})
+	return diagnostics
+}
+
+// sequences, endpoints, local entries and templates are all deployed as entries of the same registry, so their
+// names have to be unique across these kinds
+func getSynapseKeyspace(kind string) string {
+	switch kind {
+	case SynapseKindSequence, SynapseKindEndpoint, SynapseKindLocalEntry, SynapseKindTemplate:
+		return "registry"
+	}
+	return kind
+}
+
+// a key is defined by an artifact of its kind, or by a local entry for keys looked up in the local registry
+func isSynapseKeyDefined(definitions map[string]map[string]SynapseConfig, reference SynapseReference) bool {
+	if _, found := definitions[reference.Kind][reference.Key]; found {
+		return true
+	}
+	switch reference.Kind {
+	case SynapseKindSequence:
+		if ContainsString(builtInSequences, reference.Key) {
+			return true
+		}
+		fallthrough
+	case SynapseKindEndpoint, SynapseKindTemplate:
+		_, found := definitions[SynapseKindLocalEntry][reference.Key]
+		return found
+	}
+	return false
+}
+
+func lintProxy(config SynapseConfig) []LintDiagnostic {
+	target := config.root.child("target")
+	if target == nil {
+		return []LintDiagnostic{newConfigDiagnostic(config, LintRuleProxyWithoutTarget,
+			describeConfig(config)+" has no target")}
+	}
+	if target.Attributes["inSequence"] == "" && target.Attributes["endpoint"] == "" &&
+		target.child("inSequence") == nil && target.child(SynapseKindEndpoint) == nil {
+		return []LintDiagnostic{{Rule: LintRuleProxyWithoutTarget, File: config.File, Line: target.Line,
+			Column: target.Column, Message: "target of " + describeConfig(config) +
+				" has neither an in sequence nor an endpoint"}}
+	}
+	return nil
+}
+
+func lintTask(config SynapseConfig) []LintDiagnostic {
+	var diagnostics []LintDiagnostic
+	class := config.root.Attributes["class"]
+	if class == "" {
+		diagnostics = append(diagnostics, newConfigDiagnostic(config, LintRuleTaskMissingProperty,
+			describeConfig(config)+" has no class"))
+	}
+	if config.root.child("trigger") == nil {
+		diagnostics = append(diagnostics, newConfigDiagnostic(config, LintRuleTaskMissingProperty,
+			describeConfig(config)+" has no trigger"))
+	}
+
+	properties := config.TaskProperties()
+	required := requiredTaskProperties[class]
+	switch properties["injectTo"] {
+	case SynapseKindSequence:
+		required = append(required, []string{"sequenceName"})
+	case SynapseKindProxy:
+		required = append(required, []string{"proxyName"})
+	}
+	for _, group := range required {
+		found := false
+		for _, name := range group {
+			_, found = properties[name]
+			if found {
+				break
+			}
+		}
+		if !found {
+			diagnostics = append(diagnostics, newConfigDiagnostic(config, LintRuleTaskMissingProperty,
+				describeConfig(config)+" misses the "+strings.Join(group, " or ")+" property required by "+
+					class))
+		}
+	}
+	return diagnostics
+}
+
+// APIs overlap when their contexts resolve to the same path for the same version
+func lintAPIContexts(configs []SynapseConfig) []LintDiagnostic {
+	var diagnostics []LintDiagnostic
+	paths := make(map[string]SynapseConfig)
+	for _, config := range configs {
+		if config.Kind != SynapseKindAPI {
+			continue
+		}
+		path := getAPIPath(config)
+		if first, found := paths[path]; found {
+			if first.Name != config.Name {
+				diagnostics = append(diagnostics, newConfigDiagnostic(config, LintRuleOverlappingAPI,
+					describeConfig(config)+" serves "+path+" which is already served by "+describeConfig(first)+
+						" at "+getConfigPosition(first)))
+			}
+			continue
+		}
+		paths[path] = config
+	}
+	return diagnostics
+}
+
+// path served by an API, with the version in the path for APIs versioned by url or context and after the path
+// for APIs with a version but no version type
+func getAPIPath(config SynapseConfig) string {
+	context := "/" + strings.Trim(config.root.Attributes["context"], "/")
+	version := config.root.Attributes["version"]
+	if version == "" {
+		return context
+	}
+	switch config.root.Attributes["version-type"] {
+	case "url":
+		return strings.TrimSuffix(context, "/") + "/" + version
+	case "context":
+		return strings.Replace(context, "{version}", version, -1)
+	}
+	return context + " version " + version
+}
+
+func newConfigDiagnostic(config SynapseConfig, rule, message string) LintDiagnostic {
+	return LintDiagnostic{Rule: rule, File: config.File, Line: config.Line(), Column: config.Column(),
+		Message: message}
+}
+
+func describeConfig(config SynapseConfig) string {
+	return synapseKindNames[config.Kind] + " " + config.Name
+}
+
+func getConfigPosition(config SynapseConfig) string {
+	return config.File + ":" + strconv.Itoa(config.Line())
+}
+
+// String describes a diagnostic in the file:line:column: message form understood by editors
+func (diagnostic LintDiagnostic) String() string {
+	position := diagnostic.File + ":" + strconv.Itoa(diagnostic.Line)
+	if diagnostic.Column > 0 {
+		position += ":" + strconv.Itoa(diagnostic.Column)
+	}
+	return position + ": " + diagnostic.Message + " [" + diagnostic.Rule + "]"
+}
+
+// Convert diagnostics to a SARIF document
+// @param diagnostics : diagnostics of a lint run
+// @return SARIF document with a single run listing all the lint rules
+func NewLintSarifLog(diagnostics []LintDiagnostic) SarifLog {
+	driver := sarifDriver{Name: ProjectName}
+	for _, rule := range LintRules {
+		driver.Rules = append(driver.Rules, sarifRule{Id: rule.Id, ShortDescription: sarifMessage{rule.Description}})
+	}
+	results := []sarifResult{}
+	for _, diagnostic := range diagnostics {
+		uri := (&url.URL{Path: filepath.ToSlash(diagnostic.File)}).String()
+		results = append(results, sarifResult{RuleId: diagnostic.Rule, Level: "error",
+			Message: sarifMessage{diagnostic.Message},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{Uri: uri},
+				Region:           sarifRegion{StartLine: diagnostic.Line, StartColumn: diagnostic.Column}}}}})
+	}
+	return SarifLog{Schema: sarifSchema, Version: sarifVersion,
+		Runs: []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}}}
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func lintSynapseDirectory(t *testing.T, files map[string]string) []LintDiagnostic {
+	dir := createSynapseDirectory(t, files)
+	defer os.RemoveAll(dir)
+	configs, fileErrors, err := LoadSynapseConfigs(dir)
+	if err != nil {
+		t.Fatal("Error loading the configurations: ", err)
+	}
+	diagnostics := LintSynapseConfigs(configs, fileErrors)
+	for i := range diagnostics {
+		diagnostics[i].File, _ = filepath.Rel(dir, diagnostics[i].File)
+		diagnostics[i].File = filepath.ToSlash(diagnostics[i].File)
+	}
+	return diagnostics
+}
+
+func TestLintUndefinedReferences(t *testing.T) {
+	diagnostics := lintSynapseDirectory(t, map[string]string{
+		"apis/OrderAPI.xml": "<api name=\"OrderAPI\" context=\"/order\">\n" +
+			"  <resource inSequence=\"OrderSeq\" faultSequence=\"fault\">\n" +
+			"    <outSequence><call-template target=\"Missing\"/></outSequence>\n" +
+			"  </resource>\n</api>",
+		"sequences/OrderSeq.xml": "<sequence name=\"OrderSeq\">\n  <call><endpoint key=\"StockEP\"/></call>\n" +
+			"  <sequence key=\"{get-property('next')}\"/>\n  <sequence key=\"Shared\"/>\n</sequence>",
+		"local-entries/Shared.xml":           `<localEntry key="Shared"><sequence/></localEntry>`,
+		"message-processors/OrderProc.xml":   `<messageProcessor name="OrderProc" messageStore="OrderStore"/>`,
+		"message-stores/AuditStore.xml":      `<messageStore name="AuditStore"/>`,
+		"proxy-services/StockQuoteProxy.xml": `<proxy name="StockQuoteProxy"><target inSequence="main"/></proxy>`,
+	})
+	AssertEqual(t, 3, len(diagnostics))
+	AssertEqual(t, "apis/OrderAPI.xml:3:18: template 'Missing' used by API OrderAPI is not defined "+
+		"[undefined-template]", diagnostics[0].String())
+	AssertEqual(t, LintRuleUndefinedMessageStore, diagnostics[1].Rule)
+	AssertEqual(t, "sequences/OrderSeq.xml:2:9: endpoint 'StockEP' used by sequence OrderSeq is not defined "+
+		"[undefined-endpoint]", diagnostics[2].String())
+}
+
+func TestLintConflicts(t *testing.T) {
+	diagnostics := lintSynapseDirectory(t, map[string]string{
+		"apis/A.xml":           `<api name="A" context="/order"/>`,
+		"apis/B.xml":           `<api name="B" context="/order/"/>`,
+		"apis/V1.xml":          `<api name="V1" context="/stock" version="1.0" version-type="url"/>`,
+		"apis/V2.xml":          `<api name="V2" context="/stock" version="2.0" version-type="url"/>`,
+		"sequences/Seq.xml":    `<sequence name="Seq"/>`,
+		"sequences/SeqOld.xml": `<sequence name="Seq"/>`,
+		"sequences/Bad.xml":    "<sequence name=\"Bad\">\n<log>",
+	})
+	AssertEqual(t, 3, len(diagnostics))
+	AssertEqual(t, LintRuleOverlappingAPI, diagnostics[0].Rule)
+	AssertEqual(t, "apis/B.xml", diagnostics[0].File)
+	AssertEqual(t, LintRuleInvalidConfiguration, diagnostics[1].Rule)
+	AssertEqual(t, 2, diagnostics[1].Line)
+	AssertEqual(t, LintRuleDuplicateName, diagnostics[2].Rule)
+	AssertEqual(t, "sequences/SeqOld.xml", diagnostics[2].File)
+}
+
+func TestLintDuplicateRegistryKeys(t *testing.T) {
+	diagnostics := lintSynapseDirectory(t, map[string]string{
+		"sequences/Order.xml":     `<sequence name="Order"/>`,
+		"local-entries/Order.xml": `<localEntry key="Order">order</localEntry>`,
+		"apis/Order.xml":          `<api name="Order" context="/order"/>`,
+	})
+	AssertEqual(t, 1, len(diagnostics))
+	AssertEqual(t, LintRuleDuplicateName, diagnostics[0].Rule)
+	AssertEqual(t, "local-entries/Order.xml", diagnostics[0].File)
+	AssertEqual(t, true, strings.Contains(diagnostics[0].Message, "already defined as sequence Order"))
+}
+
+func TestLintProxiesAndTasks(t *testing.T) {
+	diagnostics := lintSynapseDirectory(t, map[string]string{
+		"proxy-services/NoTarget.xml": `<proxy name="NoTarget"/>`,
+		"proxy-services/Empty.xml":    `<proxy name="Empty"><target/></proxy>`,
+		"tasks/Inject.xml": `<task name="Inject" class="org.apache.synapse.startup.tasks.MessageInjector">` +
+			`<trigger interval="5"/><property name="message"><request/></property>` +
+			`<property name="injectTo" value="sequence"/><property name="sequenceName" value="Missing"/></task>`,
+		"tasks/NoClass.xml": `<task name="NoClass"><trigger count="1"/></task>`,
+	})
+	AssertEqual(t, 4, len(diagnostics))
+	AssertEqual(t, "target of proxy service Empty has neither an in sequence nor an endpoint",
+		diagnostics[0].Message)
+	AssertEqual(t, "proxy service NoTarget has no target", diagnostics[1].Message)
+	AssertEqual(t, LintRuleUndefinedSequence, diagnostics[2].Rule)
+	AssertEqual(t, "task NoClass has no class", diagnostics[3].Message)
+}
+
+func TestNewLintSarifLog(t *testing.T) {
+	log := NewLintSarifLog([]LintDiagnostic{{Rule: LintRuleDuplicateName, File: "sequences/my seq.xml", Line: 3,
+		Column: 1, Message: "sequence Seq is already defined"}})
+	AssertEqual(t, "2.1.0", log.Version)
+	AssertEqual(t, len(LintRules), len(log.Runs[0].Tool.Driver.Rules))
+	result := log.Runs[0].Results[0]
+	AssertEqual(t, LintRuleDuplicateName, result.RuleId)
+	AssertEqual(t, "sequences/my%20seq.xml", result.Locations[0].PhysicalLocation.ArtifactLocation.Uri)
+	AssertEqual(t, 3, result.Locations[0].PhysicalLocation.Region.StartLine)
+}
@@ -0,0 +1,79 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// LoggerProfile holds the levels and the classes of the loggers of a Micro Integrator. It is written in the form of
+// the loggers section of a state file, so that a profile can also be applied with the apply command.
+type LoggerProfile struct {
+	Loggers map[string]LoggerState `yaml:"loggers"`
+}
+
+// Fetch the levels and the classes of all the loggers of a remote
+// @param remote : Micro Integrator to fetch the loggers of
+// @return profile of the loggers
+// @return error if the loggers cannot be fetched
+func FetchLoggerProfile(remote Remote) (LoggerProfile, error) {
+	var loggers LoggerList
+	if err := FetchRemoteData(remote, PrefixLogging, nil, &loggers); err != nil {
+		return LoggerProfile{}, errors.New("fetching log levels failed: " + err.Error())
+	}
+	profile := LoggerProfile{Loggers: make(map[string]LoggerState)}
+	for _, logger := range loggers.Loggers {
+		profile.Loggers[logger.LoggerName] = LoggerState{Level: strings.ToUpper(logger.LogLevel),
+			Class: logger.ComponentName}
+	}
+	return profile, nil
+}
+
+// Write a logger profile to a YAML file, with the loggers ordered by name
+func WriteLoggerProfile(path string, profile LoggerProfile) error {
+	data, err := yaml.Marshal(profile)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// Read a logger profile. The file is validated as a state file which may only hold loggers.
+// @param path : path of the YAML file
+// @return profile of the loggers
+// @return error if the file cannot be read, is not a valid state file, holds other settings or no loggers
+func ReadLoggerProfile(path string) (LoggerProfile, error) {
+	state, err := ReadDesiredState(path)
+	if err != nil {
+		return LoggerProfile{}, err
+	}
+	if len(state.Endpoints) > 0 || len(state.ProxyServices) > 0 || len(state.MessageProcessors) > 0 ||
+		len(state.Users) > 0 {
+		return LoggerProfile{}, errors.New(path + " holds settings other than loggers, apply it with the " +
+			"apply command")
+	}
+	if len(state.Loggers) == 0 {
+		return LoggerProfile{}, errors.New(path + " holds no loggers")
+	}
+	return LoggerProfile{Loggers: state.Loggers}, nil
+}
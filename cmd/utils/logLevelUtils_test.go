@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFetchLoggerProfile(t *testing.T) {
+	server, remote := createRemoteServer(t, map[string]string{
+		PrefixLogging: `{"count": 2, "list": [{"loggerName": "root", "componentName": "root", "level": "info"}, ` +
+			`{"loggerName": "com-example", "componentName": "com.example", "level": "DEBUG"}]}`,
+	})
+	defer server.Close()
+
+	profile, err := FetchLoggerProfile(remote)
+	if err != nil {
+		t.Fatal("Error fetching the loggers: ", err)
+	}
+	AssertEqual(t, 2, len(profile.Loggers))
+	AssertEqual(t, LoggerState{Level: "INFO", Class: "root"}, profile.Loggers["root"])
+	AssertEqual(t, LoggerState{Level: "DEBUG", Class: "com.example"}, profile.Loggers["com-example"])
+}
+
+func TestWriteAndReadLoggerProfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "loggers")
+	if err != nil {
+		t.Fatal("Error creating the directory: ", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "loggers.yaml")
+	profile := LoggerProfile{Loggers: map[string]LoggerState{"root": {Level: "WARN", Class: "root"},
+		"com-example": {Level: "TRACE", Class: "com.example"}}}
+	if err = WriteLoggerProfile(path, profile); err != nil {
+		t.Fatal("Error writing the loggers: ", err)
+	}
+
+	read, err := ReadLoggerProfile(path)
+	if err != nil {
+		t.Fatal("Error reading the loggers: ", err)
+	}
+	AssertEqual(t, 2, len(read.Loggers))
+	AssertEqual(t, profile.Loggers["root"], read.Loggers["root"])
+	AssertEqual(t, profile.Loggers["com-example"], read.Loggers["com-example"])
+}
+
+func TestReadLoggerProfileInvalid(t *testing.T) {
+	for _, content := range []string{"loggers:\n  root: INFO\nendpoints:\n  StockEP: active\n", "loggers: {}\n",
+		"loggers:\n  root: LOUD\n"} {
+		path := writeStateFile(t, content)
+		if _, err := ReadLoggerProfile(path); err == nil {
+			t.Error("Expected an error reading the loggers " + content)
+		}
+		os.Remove(path)
+	}
+}
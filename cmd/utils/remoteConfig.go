@@ -47,11 +47,12 @@ func (remoteConfig *RemoteConfig) AddRemote(name string, host string, port strin
 func (remoteConfig *RemoteConfig) UpdateRemote(name string, host string, port string) error {
 
 	remotes := &RemoteConfigData.Remotes
-	if _, exists := (*remotes)[name]; !exists {
+	current, exists := (*remotes)[name]
+	if !exists {
 		return errors.New("no such remote: " + name)
 	}
 
-	remote := Remote{Url: host, Port: port}
+	remote := Remote{Url: host, Port: port, Protected: current.Protected}
 	(*remotes)[name] = remote
 
 	return nil
@@ -63,7 +64,8 @@ func (remoteConfig *RemoteConfig) UpdateCurrentRemoteToken(accessToken string) e
 
 	remotes := &RemoteConfigData.Remotes
 
-	remote := Remote{Url: currentRemote.Url, Port: currentRemote.Port, AccessToken: accessToken}
+	remote := Remote{Url: currentRemote.Url, Port: currentRemote.Port, AccessToken: accessToken,
+		Protected: currentRemote.Protected}
 	(*remotes)[RemoteConfigData.CurrentRemote] = remote
 
 	return nil
@@ -29,6 +29,8 @@ type Remote struct {
 	Url         string `yaml:"remote_address"`
 	Port        string `yaml:"remote_port"`
 	AccessToken string `yaml:"access_token"`
+	// Protected remotes are confirmed by typing their name before destructive operations
+	Protected bool `yaml:"protected,omitempty"`
 }
 
 type RemoteInfo struct {
@@ -46,6 +48,11 @@ type Logger struct {
 	LogLevel      string `json:"level"`
 }
 
+type LoggerList struct {
+	Count   int32    `json:"count"`
+	Loggers []Logger `json:"list"`
+}
+
 type Service struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
@@ -67,11 +74,6 @@ type LoginResponse struct {
 type LogoutResponse struct {
 }
 
-type IterableStringArray interface {
-	GetCount() int32
-	GetDataIterator() <-chan []string
-}
-
 type KeyStore struct {
 	Location string
 	Type     string
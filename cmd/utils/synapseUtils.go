@@ -0,0 +1,461 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// SynapseDirectory is a directory of the conventional layout of Synapse artifacts kept in source control
+type SynapseDirectory struct {
+	// Name of the directory
+	Name string
+	// CarType is the type of the artifacts in Composite App metadata
+	CarType string
+	// RootElement is the root element of the configuration of the artifacts
+	RootElement string
+	// NameAttribute is the attribute of the root element holding the name of an artifact
+	NameAttribute string
+}
+
+var SynapseDirectories = []SynapseDirectory{
+	{Name: "apis", CarType: "synapse/api", RootElement: "api", NameAttribute: "name"},
+	{Name: "sequences", CarType: "synapse/sequence", RootElement: "sequence", NameAttribute: "name"},
+	{Name: "endpoints", CarType: "synapse/endpoint", RootElement: "endpoint", NameAttribute: "name"},
+	{Name: "proxy-services", CarType: "synapse/proxy-service", RootElement: "proxy", NameAttribute: "name"},
+	{Name: "local-entries", CarType: "synapse/local-entry", RootElement: "localEntry", NameAttribute: "key"},
+	{Name: "tasks", CarType: "synapse/task", RootElement: "task", NameAttribute: "name"},
+	{Name: "templates", CarType: "synapse/template", RootElement: "template", NameAttribute: "name"},
+	{Name: "message-stores", CarType: "synapse/message-store", RootElement: "messageStore", NameAttribute: "name"},
+	{Name: "message-processors", CarType: "synapse/message-processors", RootElement: "messageProcessor",
+		NameAttribute: "name"},
+	{Name: "inbound-endpoints", CarType: "synapse/inbound-endpoint", RootElement: "inboundEndpoint",
+		NameAttribute: "name"},
+}
+
+// type of the endpoint templates in Composite App metadata, which share the templates directory
+const carEndpointTemplateType = "synapse/endpointTemplate"
+
+// SynapseArtifact is the configuration of an artifact read from a Synapse artifact directory
+type SynapseArtifact struct {
+	Name    string
+	CarType string
+	// Path of the configuration file relative to the artifact directory
+	Path    string
+	Content []byte
+}
+
+// Kinds of Synapse artifacts, named after the root elements of their configurations
+const SynapseKindAPI = "api"
+const SynapseKindSequence = "sequence"
+const SynapseKindEndpoint = "endpoint"
+const SynapseKindProxy = "proxy"
+const SynapseKindLocalEntry = "localEntry"
+const SynapseKindTask = "task"
+const SynapseKindTemplate = "template"
+const SynapseKindMessageStore = "messageStore"
+const SynapseKindMessageProcessor = "messageProcessor"
+const SynapseKindInboundEndpoint = "inboundEndpoint"
+
+// sequences which are always defined in a Micro Integrator
+var builtInSequences = []string{"main", "fault"}
+
+// attributes holding the key of a sequence on any element, such as the resources of an API
+var sequenceKeyAttributes = []string{"inSequence", "outSequence", "faultSequence", "onError"}
+
+// parameters of a message processor holding the key of a sequence
+var messageProcessorSequenceParameters = []string{"message.processor.reply.sequence",
+	"message.processor.fault.sequence", "message.processor.deactivate.sequence"}
+
+// SynapseConfig is the parsed configuration of a Synapse artifact
+type SynapseConfig struct {
+	// Kind is the root element of the configuration, such as api or sequence
+	Kind string
+	Name string
+	// File is the path of the configuration, within the archive for configurations read from a .car file
+	File string
+	root *xmlNode
+}
+
+// SynapseFileError is a configuration which could not be parsed
+type SynapseFileError struct {
+	File    string
+	Line    int
+	Column  int
+	Message string
+}
+
+// SynapseReference is the key of another artifact used in a configuration
+type SynapseReference struct {
+	// Kind of the referenced artifact, such as sequence or endpoint
+	Kind   string
+	Key    string
+	Line   int
+	Column int
+}
+
+// Read the artifacts of a directory with the conventional Synapse layout, such as apis/ and sequences/
+// @param dir : root of the layout
+// @return artifacts ordered by directory and file name
+// @return error if a configuration is not well formed, is not of the type of its directory or has no name
+func ReadSynapseDirectory(dir string) ([]SynapseArtifact, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, errors.New(dir + " is not a directory")
+	}
+	var artifacts []SynapseArtifact
+	err = walkSynapseDirectory(dir, func(synapseDirectory SynapseDirectory, path string, content []byte) error {
+		artifact, err := readSynapseArtifact(synapseDirectory, path, content)
+		if err != nil {
+			return err
+		}
+		artifacts = append(artifacts, artifact)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return artifacts, nil
+}
+
+// call visit with each configuration of a directory with the conventional Synapse layout, ordered by directory and
+// file name, with its path relative to the directory such as apis/OrderAPI.xml
+func walkSynapseDirectory(dir string, visit func(synapseDirectory SynapseDirectory, path string,
+	content []byte) error) error {
+	for _, synapseDirectory := range SynapseDirectories {
+		files, err := ioutil.ReadDir(filepath.Join(dir, synapseDirectory.Name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		sort.Slice(files, func(i, j int) bool { return files[i].Name() < files[j].Name() })
+		for _, file := range files {
+			if file.IsDir() || !strings.HasSuffix(strings.ToLower(file.Name()), ".xml") {
+				continue
+			}
+			path := filepath.ToSlash(filepath.Join(synapseDirectory.Name, file.Name()))
+			content, err := ioutil.ReadFile(filepath.Join(dir, path))
+			if err != nil {
+				return err
+			}
+			if err = visit(synapseDirectory, path, content); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readSynapseArtifact(synapseDirectory SynapseDirectory, path string, content []byte) (SynapseArtifact, error) {
+	config, fileError := parseSynapseConfig(synapseDirectory, path, content)
+	if fileError != nil {
+		return SynapseArtifact{}, errors.New(path + ": " + fileError.Message)
+	}
+	artifact := SynapseArtifact{Name: config.Name, CarType: synapseDirectory.CarType, Path: path, Content: content}
+	if config.IsEndpointTemplate() {
+		artifact.CarType = carEndpointTemplateType
+	}
+	return artifact, nil
+}
+
+// Load the configurations of the Synapse artifacts in a directory with the conventional layout or in a
+// Composite App archive. Configurations which cannot be parsed are returned as file errors instead of
+// failing the whole load
+// @param path : path of the directory or the .car file
+// @return configurations ordered by file
+// @return configurations which are not well formed, are not of the expected type or have no name
+// @return error if the directory or the archive cannot be read
+func LoadSynapseConfigs(path string) ([]SynapseConfig, []SynapseFileError, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !info.IsDir() {
+		carFile, err := ReadCarFile(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		configs, fileErrors := loadCarFileConfigs(carFile)
+		return configs, fileErrors, nil
+	}
+
+	var configs []SynapseConfig
+	var fileErrors []SynapseFileError
+	err = walkSynapseDirectory(path, func(synapseDirectory SynapseDirectory, file string, content []byte) error {
+		config, fileError := parseSynapseConfig(synapseDirectory, filepath.Join(path, file), content)
+		if fileError != nil {
+			fileErrors = append(fileErrors, *fileError)
+		} else {
+			configs = append(configs, config)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return configs, fileErrors, nil
+}
+
+// parse the configurations of the Synapse artifacts bundled in an archive, named after the archive and the
+// entry such as OrderCApp_1.0.0.car!/OrderAPI_1.0.0/OrderAPI.xml
+func loadCarFileConfigs(carFile CarFile) ([]SynapseConfig, []SynapseFileError) {
+	var configs []SynapseConfig
+	var fileErrors []SynapseFileError
+	for _, artifact := range carFile.Artifacts {
+		if artifact.Missing || artifact.File == "" {
+			continue
+		}
+		synapseDirectory, found := getSynapseDirectoryOfCarType(artifact.Type)
+		if !found {
+			continue
+		}
+		content, found := carFile.ReadFile(artifact.File)
+		if !found {
+			continue
+		}
+		config, fileError := parseSynapseConfig(synapseDirectory, carFile.Path+"!/"+artifact.File, content)
+		if fileError != nil {
+			fileErrors = append(fileErrors, *fileError)
+		} else {
+			configs = append(configs, config)
+		}
+	}
+	sort.SliceStable(configs, func(i, j int) bool { return configs[i].File < configs[j].File })
+	return configs, fileErrors
+}
+
+// find the directory of the artifacts of a type name used in artifact metadata, such as api
+func getSynapseDirectoryOfCarType(carType string) (SynapseDirectory, bool) {
+	// endpoint templates share the templates directory with sequence templates
+	if carType == GetCarArtifactTypeName(carEndpointTemplateType) {
+		carType = SynapseKindTemplate
+	}
+	for _, synapseDirectory := range SynapseDirectories {
+		if GetCarArtifactTypeName(synapseDirectory.CarType) == carType {
+			return synapseDirectory, true
+		}
+	}
+	return SynapseDirectory{}, false
+}
+
+func parseSynapseConfig(synapseDirectory SynapseDirectory, file string, content []byte) (SynapseConfig,
+	*SynapseFileError) {
+	root, fileError := parseSynapseDocument(file, content)
+	if fileError != nil {
+		return SynapseConfig{}, fileError
+	}
+	if root.Name != synapseDirectory.RootElement {
+		return SynapseConfig{}, &SynapseFileError{File: file, Line: root.Line, Column: root.Column,
+			Message: "expected a <" + synapseDirectory.RootElement + "> element in " + synapseDirectory.Name +
+				"/ but found <" + root.Name + ">"}
+	}
+	return newSynapseConfig(synapseDirectory, file, root)
+}
+
+// parse a configuration of any kind, such as one fetched from a server
+func parseAnySynapseConfig(file string, content []byte) (SynapseConfig, *SynapseFileError) {
+	root, fileError := parseSynapseDocument(file, content)
+	if fileError != nil {
+		return SynapseConfig{}, fileError
+	}
+	for _, synapseDirectory := range SynapseDirectories {
+		if root.Name == synapseDirectory.RootElement {
+			return newSynapseConfig(synapseDirectory, file, root)
+		}
+	}
+	return SynapseConfig{}, &SynapseFileError{File: file, Line: root.Line, Column: root.Column,
+		Message: "<" + root.Name + "> is not a Synapse artifact configuration"}
+}
+
+func parseSynapseDocument(file string, content []byte) (*xmlNode, *SynapseFileError) {
+	document, err := parseXMLDocument(content)
+	if err != nil {
+		fileError := &SynapseFileError{File: file, Line: 1, Message: err.Error()}
+		if syntaxError, ok := err.(*xml.SyntaxError); ok {
+			fileError.Line = syntaxError.Line
+			fileError.Message = syntaxError.Msg
+		}
+		return nil, fileError
+	}
+	if len(document.Children) == 0 {
+		return nil, &SynapseFileError{File: file, Line: 1, Message: "no root element found"}
+	}
+	return document.Children[0], nil
+}
+
+func newSynapseConfig(synapseDirectory SynapseDirectory, file string, root *xmlNode) (SynapseConfig,
+	*SynapseFileError) {
+	name := root.Attributes[synapseDirectory.NameAttribute]
+	if name == "" {
+		return SynapseConfig{}, &SynapseFileError{File: file, Line: root.Line, Column: root.Column,
+			Message: "<" + root.Name + "> has no " + synapseDirectory.NameAttribute + " attribute"}
+	}
+	return SynapseConfig{Kind: root.Name, Name: name, File: file, root: root}, nil
+}
+
+// Fetch the Synapse configurations of all the artifacts deployed in a remote, named after their resource and name
+// such as apis/OrderAPI
+// @param remote : Micro Integrator to fetch the configurations from
+// @return configurations ordered by artifact type and name
+// @return configurations which could not be parsed
+// @return error if a list or a configuration could not be fetched
+func FetchSynapseConfigs(remote Remote) ([]SynapseConfig, []SynapseFileError, error) {
+	configurations, err := FetchArtifactConfigurations(remote, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var configs []SynapseConfig
+	var fileErrors []SynapseFileError
+	for _, configuration := range configurations {
+		if configuration.Err != nil {
+			return nil, nil, configuration.Err
+		}
+		file := configuration.ArtifactType.Resource + "/" + configuration.Name
+		config, fileError := parseAnySynapseConfig(file, []byte(configuration.Configuration))
+		if fileError != nil {
+			fileErrors = append(fileErrors, *fileError)
+		} else {
+			configs = append(configs, config)
+		}
+	}
+	return configs, fileErrors, nil
+}
+
+// IsEndpointTemplate tells whether the configuration is a template of an endpoint rather than of a sequence
+func (config SynapseConfig) IsEndpointTemplate() bool {
+	return config.Kind == SynapseKindTemplate && config.root.child(SynapseKindEndpoint) != nil
+}
+
+// Line returns the line of the root element of the configuration
+func (config SynapseConfig) Line() int {
+	return config.root.Line
+}
+
+// Column returns the column of the root element of the configuration
+func (config SynapseConfig) Column() int {
+	return config.root.Column
+}
+
+// References returns the keys of the sequences, endpoints, templates, message stores and proxy services used in
+// the configuration, leaving out dynamic keys, registry paths and template parameters which cannot be resolved
+// without a running server
+func (config SynapseConfig) References() []SynapseReference {
+	var references []SynapseReference
+	add := func(kind, key string, node *xmlNode) {
+		if key != "" && !strings.ContainsAny(key, "{}:$") {
+			references = append(references, SynapseReference{Kind: kind, Key: key, Line: node.Line,
+				Column: node.Column})
+		}
+	}
+	var walk func(node *xmlNode)
+	walk = func(node *xmlNode) {
+		switch node.Name {
+		case SynapseKindSequence:
+			if node != config.root {
+				add(SynapseKindSequence, node.Attributes["key"], node)
+			}
+		case SynapseKindEndpoint:
+			add(SynapseKindEndpoint, node.Attributes["key"], node)
+			add(SynapseKindTemplate, node.Attributes["template"], node)
+		case "call-template":
+			add(SynapseKindTemplate, node.Attributes["target"], node)
+		case "store":
+			add(SynapseKindMessageStore, node.Attributes["messageStore"], node)
+		case "target":
+			add(SynapseKindSequence, node.Attributes["sequence"], node)
+			add(SynapseKindEndpoint, node.Attributes["endpoint"], node)
+		}
+		for _, attribute := range sequenceKeyAttributes {
+			add(SynapseKindSequence, node.Attributes[attribute], node)
+		}
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	walk(config.root)
+
+	switch config.Kind {
+	case SynapseKindInboundEndpoint:
+		add(SynapseKindSequence, config.root.Attributes["sequence"], config.root)
+	case SynapseKindMessageProcessor:
+		add(SynapseKindMessageStore, config.root.Attributes["messageStore"], config.root)
+		add(SynapseKindEndpoint, config.root.Attributes["targetEndpoint"], config.root)
+		for _, parameter := range config.root.children("parameter") {
+			if ContainsString(messageProcessorSequenceParameters, parameter.Attributes["name"]) {
+				add(SynapseKindSequence, strings.TrimSpace(parameter.Text), parameter)
+			}
+		}
+	case SynapseKindTask:
+		properties := config.TaskProperties()
+		switch properties["injectTo"] {
+		case SynapseKindSequence:
+			add(SynapseKindSequence, properties["sequenceName"], config.root)
+		case SynapseKindProxy:
+			add(SynapseKindProxy, properties["proxyName"], config.root)
+		}
+	}
+	return references
+}
+
+// TaskProperties returns the properties passed to the class of a task, with an empty value for the properties
+// holding XML content such as the injected message
+func (config SynapseConfig) TaskProperties() map[string]string {
+	properties := make(map[string]string)
+	if config.Kind != SynapseKindTask {
+		return properties
+	}
+	for _, property := range config.root.children("property") {
+		if name, found := property.Attributes["name"]; found {
+			properties[name] = property.Attributes["value"]
+		}
+	}
+	return properties
+}
+
+// first child element with the given name
+func (node *xmlNode) child(name string) *xmlNode {
+	for _, child := range node.Children {
+		if child.Name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+// child elements with the given name
+func (node *xmlNode) children(name string) []*xmlNode {
+	var children []*xmlNode
+	for _, child := range node.Children {
+		if child.Name == name {
+			children = append(children, child)
+		}
+	}
+	return children
+}
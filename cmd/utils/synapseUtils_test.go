@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// createSynapseDirectory writes the given configurations, keyed by relative path, to a temporary directory
+func createSynapseDirectory(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "synapse")
+	if err != nil {
+		t.Fatal("Error creating the directory: ", err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal("Error creating the directory: ", err)
+		}
+		if err = ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal("Error writing the configuration: ", err)
+		}
+	}
+	return dir
+}
+
+func TestBuildCarFile(t *testing.T) {
+	dir := createSynapseDirectory(t, map[string]string{
+		"apis/OrderAPI.xml":         `<api xmlns="http://ws.apache.org/ns/synapse" name="OrderAPI" context="/order"/>`,
+		"sequences/OrderSeq.xml":    `<sequence xmlns="http://ws.apache.org/ns/synapse" name="OrderSeq"/>`,
+		"templates/EPTemplate.xml":  `<template name="EPTemplate"><endpoint name="ep"/></template>`,
+		"templates/LogTemplate.xml": `<template name="LogTemplate"><sequence/></template>`,
+		"sequences/README.md":       "not a configuration",
+	})
+	defer os.RemoveAll(dir)
+
+	artifacts, err := ReadSynapseDirectory(dir)
+	if err != nil {
+		t.Fatal("Error reading the directory: ", err)
+	}
+	AssertEqual(t, 4, len(artifacts))
+
+	output := filepath.Join(dir, "OrderCApp_1.0.0.car")
+	application := CarArtifact{Name: "OrderCApp", GroupId: "com.example", Version: "1.0.0"}
+	if err = BuildCarFile(artifacts, application, output); err != nil {
+		t.Fatal("Error building the archive: ", err)
+	}
+	carFile, err := ReadCarFile(output)
+	if err != nil {
+		t.Fatal("Error reading the archive: ", err)
+	}
+	AssertEqual(t, "OrderCApp", carFile.Application.Name)
+	AssertEqual(t, "1.0.0", carFile.Application.Version)
+	types := make(map[string]string)
+	for _, artifact := range carFile.Artifacts {
+		AssertEqual(t, false, artifact.Missing)
+		types[artifact.Name] = GetCarArtifactTypeName(artifact.Type)
+	}
+	AssertEqual(t, 4, len(types))
+	AssertEqual(t, "api", types["OrderAPI"])
+	AssertEqual(t, "endpointTemplate", types["EPTemplate"])
+	AssertEqual(t, "template", types["LogTemplate"])
+}
+
+func TestBuildCarFileLeavesNoPartialArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "car")
+	if err != nil {
+		t.Fatal("Error creating a temporary directory: ", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the archive cannot replace a directory of the same name
+	output := filepath.Join(dir, "OrderCApp_1.0.0.car")
+	if err = os.Mkdir(output, 0755); err != nil {
+		t.Fatal("Error creating a directory: ", err)
+	}
+	artifacts := []SynapseArtifact{{Name: "OrderSeq", CarType: "synapse/sequence", Path: "sequences/OrderSeq.xml",
+		Content: []byte(`<sequence name="OrderSeq"/>`)}}
+	if err = BuildCarFile(artifacts, CarArtifact{Name: "OrderCApp", Version: "1.0.0"}, output); err == nil {
+		t.Error("Expected an error when the archive cannot be written")
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal("Error reading the directory: ", err)
+	}
+	AssertEqual(t, 1, len(files))
+}
+
+func TestReadSynapseDirectoryErrors(t *testing.T) {
+	tests := map[string]string{
+		"wrong root element": `<sequence name="OrderAPI"/>`,
+		"missing name":       `<api context="/order"/>`,
+		"malformed":          `<api name="OrderAPI">`,
+	}
+	for description, content := range tests {
+		dir := createSynapseDirectory(t, map[string]string{"apis/OrderAPI.xml": content})
+		if _, err := ReadSynapseDirectory(dir); err == nil {
+			t.Error("Expected an error reading a directory with a " + description + " configuration")
+		}
+		os.RemoveAll(dir)
+	}
+}
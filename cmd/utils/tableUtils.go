@@ -0,0 +1,312 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// TableOptions controls how an artifact list is rendered
+type TableOptions struct {
+	Columns   []string
+	Filters   []string
+	SortBy    string
+	NoHeaders bool
+	Wide      bool
+}
+
+// ListTableOptions holds the table options given to the currently executing command
+var ListTableOptions TableOptions
+
+// TableStyle controls the terminal specific rendering of a table
+type TableStyle struct {
+	// Colorize status columns and changed cells
+	Colorize bool
+	// Width available for the table, values are not truncated if 0
+	Width int
+	// Previous holds the cells printed in the previous poll keyed by the first cell, changed cells are
+	// highlighted when it is not nil
+	Previous map[string][]string
+}
+
+const ansiColorReset = "\033[0m"
+const ansiColorRed = "\033[31m"
+const ansiColorYellow = "\033[33m"
+const ansiReverseVideo = "\033[7m"
+
+// per column padding added by the table writer
+const tableColumnPadding = 3
+const truncationSuffix = "..."
+const minTruncatedColumnWidth = 8
+
+// Print an artifact list as a table using the options of the current command
+// @param itemList : list of artifacts
+// @param emptyWarning : message printed when the list is empty
+func PrintItemList(itemList artifactUtils.Table, emptyWarning string) {
+	rows, err := FilterRows(itemList.GetColumns(), itemList.GetRows(), ListTableOptions.Filters)
+	if err != nil {
+		HandleErrorAndExit("Unable to filter the list.", err)
+	}
+	if len(rows) == 0 {
+		fmt.Println(emptyWarning)
+		if isWatching() {
+			updateWatch(itemList.GetColumns(), nil)
+		}
+		return
+	}
+	style := TableStyle{Colorize: IsTerminal(os.Stdout)}
+	if style.Colorize {
+		style.Width, _, _ = terminal.GetSize(int(os.Stdout.Fd()))
+	}
+	if isWatching() {
+		style.Previous = watch.previous
+	}
+	err = RenderTable(os.Stdout, itemList.GetColumns(), rows, ListTableOptions, style)
+	if err != nil {
+		HandleErrorAndExit("Unable to print the list.", err)
+	}
+	if isWatching() {
+		updateWatch(itemList.GetColumns(), rows)
+	}
+}
+
+// record the rows listed in this poll of the watch mode, also when none of them are left after filtering
+func updateWatch(columns []artifactUtils.Column, rows []artifactUtils.Row) {
+	if err := watch.update(columns, rows); err != nil {
+		// an invalid condition fails every poll, so it ends the command
+		watch.polling = false
+		HandleErrorAndExit("Invalid condition given to --until.", err)
+	}
+}
+
+// Render the given rows as a table
+// @param writer : destination of the table
+// @param columns : all the columns available for the rows
+// @param rows : rows to be printed
+// @param options : column selection, sorting and header options
+// @param style : colors, width and the previous values of the rows
+// @return error if the options refer to an unknown column
+func RenderTable(writer io.Writer, columns []artifactUtils.Column, rows []artifactUtils.Row,
+	options TableOptions, style TableStyle) error {
+
+	selected, err := selectColumns(columns, options)
+	if err != nil {
+		return err
+	}
+	if options.SortBy != "" {
+		err = sortRows(columns, rows, options.SortBy)
+		if err != nil {
+			return err
+		}
+	}
+
+	var data [][]string
+	if !options.NoHeaders {
+		header := make([]string, len(selected))
+		for i, index := range selected {
+			header[i] = columns[index].Header
+		}
+		data = append(data, header)
+	}
+	for _, row := range rows {
+		line := make([]string, len(selected))
+		for i, index := range selected {
+			if index < len(row.Cells) {
+				line[i] = row.Cells[index]
+			}
+		}
+		data = append(data, line)
+	}
+
+	if !options.Wide && style.Width > 0 {
+		truncateCells(data, style.Width)
+	}
+
+	if style.Colorize {
+		offset := len(data) - len(rows)
+		for i, row := range rows {
+			for j, index := range selected {
+				if isChangedCell(style.Previous, row, index) {
+					data[i+offset][j] = ansiReverseVideo + data[i+offset][j] + ansiColorReset
+				} else if columns[index].Status {
+					data[i+offset][j] = colorizeCell(data[i+offset][j], row.State)
+				}
+			}
+		}
+	}
+
+	table := tablewriter.NewWriter(writer)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetBorder(false)
+	table.SetColumnSeparator(" ")
+	table.SetAutoWrapText(false)
+	table.AppendBulk(data)
+	table.Render()
+	return nil
+}
+
+// IsTerminal returns true iff the file is attached to a terminal
+func IsTerminal(file *os.File) bool {
+	return terminal.IsTerminal(int(file.Fd()))
+}
+
+// Find the column matching the given name, ignoring case and treating spaces, dashes and underscores alike
+func FindColumn(columns []artifactUtils.Column, name string) (int, error) {
+	for i, column := range columns {
+		if normalizeColumnName(column.Header) == normalizeColumnName(name) {
+			return i, nil
+		}
+	}
+	var available []string
+	for _, column := range columns {
+		available = append(available, normalizeColumnName(column.Header))
+	}
+	return -1, errors.New("unknown column '" + name + "'. Available columns: " + strings.Join(available, ", "))
+}
+
+func normalizeColumnName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer(" ", "-", "_", "-").Replace(name)
+}
+
+func selectColumns(columns []artifactUtils.Column, options TableOptions) ([]int, error) {
+	var selected []int
+	if len(options.Columns) > 0 {
+		for _, name := range options.Columns {
+			index, err := FindColumn(columns, name)
+			if err != nil {
+				return nil, err
+			}
+			selected = append(selected, index)
+		}
+		return selected, nil
+	}
+	for i, column := range columns {
+		if !column.Wide || options.Wide {
+			selected = append(selected, i)
+		}
+	}
+	return selected, nil
+}
+
+// sort the rows by the given column, a leading '-' sorts in descending order
+func sortRows(columns []artifactUtils.Column, rows []artifactUtils.Row, sortBy string) error {
+	descending := strings.HasPrefix(sortBy, "-")
+	index, err := FindColumn(columns, strings.TrimPrefix(sortBy, "-"))
+	if err != nil {
+		return err
+	}
+	numeric := columns[index].Numeric
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := cellAt(rows[i], index), cellAt(rows[j], index)
+		if descending {
+			a, b = b, a
+		}
+		if numeric {
+			x, errX := strconv.ParseFloat(a, 64)
+			y, errY := strconv.ParseFloat(b, 64)
+			if errX == nil && errY == nil {
+				return x < y
+			}
+		}
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+	return nil
+}
+
+func cellAt(row artifactUtils.Row, index int) string {
+	if index < len(row.Cells) {
+		return row.Cells[index]
+	}
+	return ""
+}
+
+// shrink the widest columns until the table fits in the given width
+func truncateCells(data [][]string, width int) {
+	if len(data) == 0 {
+		return
+	}
+	widths := make([]int, len(data[0]))
+	for _, line := range data {
+		for i, cell := range line {
+			if w := tablewriter.DisplayWidth(cell); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+	total := 0
+	for _, w := range widths {
+		total += w + tableColumnPadding
+	}
+	for total > width {
+		widest := 0
+		for i, w := range widths {
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+		if widths[widest] <= minTruncatedColumnWidth {
+			break
+		}
+		widths[widest]--
+		total--
+	}
+	for _, line := range data {
+		for i, cell := range line {
+			line[i] = truncateCell(cell, widths[i])
+		}
+	}
+}
+
+func truncateCell(cell string, width int) string {
+	runes := []rune(cell)
+	if len(runes) <= width {
+		return cell
+	}
+	return string(runes[:width-len(truncationSuffix)]) + truncationSuffix
+}
+
+// a cell is changed if its row was not printed in the previous poll or was printed with a different value
+func isChangedCell(previous map[string][]string, row artifactUtils.Row, index int) bool {
+	if previous == nil {
+		return false
+	}
+	cells, found := previous[cellAt(row, 0)]
+	return !found || index >= len(cells) || cells[index] != cellAt(row, index)
+}
+
+func colorizeCell(cell string, state artifactUtils.RowState) string {
+	switch state {
+	case artifactUtils.RowStateWarning:
+		return ansiColorYellow + cell + ansiColorReset
+	case artifactUtils.RowStateError:
+		return ansiColorRed + cell + ansiColorReset
+	}
+	return cell
+}
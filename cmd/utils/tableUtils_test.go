@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+func renderMessageStores(t *testing.T, options TableOptions, width int) []string {
+	list := &artifactUtils.MessageStoreList{
+		MessageStores: []artifactUtils.MessageStore{
+			{Name: "OrderStore", Type: "jms-message-store", Size: 12},
+			{Name: "audit", Type: "in-memory-message-store", Size: 3},
+			{Name: "PaymentStore", Type: "jdbc-message-store", Size: 100},
+		},
+	}
+	buffer := new(bytes.Buffer)
+	err := RenderTable(buffer, list.GetColumns(), list.GetRows(), options, TableStyle{Width: width})
+	if err != nil {
+		t.Fatal("Error rendering table: ", err)
+	}
+	var lines []string
+	for _, line := range strings.Split(strings.TrimRight(buffer.String(), "\n"), "\n") {
+		lines = append(lines, strings.Join(strings.Fields(line), " "))
+	}
+	return lines
+}
+
+func TestRenderTableDefault(t *testing.T) {
+	lines := renderMessageStores(t, TableOptions{}, 0)
+	AssertEqual(t, 4, len(lines))
+	AssertEqual(t, "NAME TYPE SIZE", lines[0])
+	AssertEqual(t, "OrderStore jms-message-store 12", lines[1])
+}
+
+func TestRenderTableColumnsAndNoHeaders(t *testing.T) {
+	lines := renderMessageStores(t, TableOptions{Columns: []string{"size", "Name"}, NoHeaders: true}, 0)
+	AssertEqual(t, 3, len(lines))
+	AssertEqual(t, "12 OrderStore", lines[0])
+}
+
+func TestRenderTableSortNumeric(t *testing.T) {
+	lines := renderMessageStores(t, TableOptions{SortBy: "size"}, 0)
+	AssertEqual(t, "audit in-memory-message-store 3", lines[1])
+	AssertEqual(t, "PaymentStore jdbc-message-store 100", lines[3])
+
+	lines = renderMessageStores(t, TableOptions{SortBy: "-size"}, 0)
+	AssertEqual(t, "PaymentStore jdbc-message-store 100", lines[1])
+}
+
+func TestRenderTableSortIgnoresCase(t *testing.T) {
+	lines := renderMessageStores(t, TableOptions{SortBy: "name"}, 0)
+	AssertEqual(t, "audit in-memory-message-store 3", lines[1])
+	AssertEqual(t, "PaymentStore jdbc-message-store 100", lines[3])
+}
+
+func TestRenderTableUnknownColumn(t *testing.T) {
+	list := &artifactUtils.APIList{}
+	err := RenderTable(new(bytes.Buffer), list.GetColumns(), list.GetRows(),
+		TableOptions{Columns: []string{"context"}}, TableStyle{})
+	if err == nil {
+		t.Error("Expected an error for an unknown column")
+	}
+}
+
+func TestRenderTableTruncation(t *testing.T) {
+	lines := renderMessageStores(t, TableOptions{}, 36)
+	for _, line := range lines {
+		if len(line) > 36 {
+			t.Errorf("Line exceeds the given width: '%s'\n", line)
+		}
+	}
+	if !strings.Contains(lines[2], truncationSuffix) {
+		t.Errorf("Expected a truncated value, got '%s'\n", lines[2])
+	}
+
+	lines = renderMessageStores(t, TableOptions{Wide: true}, 36)
+	AssertEqual(t, "audit in-memory-message-store 3", lines[2])
+}
+
+func TestRenderTableColorize(t *testing.T) {
+	list := &artifactUtils.EndpointList{
+		Endpoints: []artifactUtils.EndpointSummary{
+			{Name: "StockEP", Type: "http", Active: true},
+			{Name: "OrderEP", Type: "address", Active: false},
+		},
+	}
+	buffer := new(bytes.Buffer)
+	err := RenderTable(buffer, list.GetColumns(), list.GetRows(), TableOptions{}, TableStyle{Colorize: true})
+	if err != nil {
+		t.Fatal("Error rendering table: ", err)
+	}
+	lines := strings.Split(buffer.String(), "\n")
+	if strings.Contains(lines[1], ansiColorYellow) {
+		t.Errorf("Active endpoint should not be colorized: '%s'\n", lines[1])
+	}
+	if !strings.Contains(lines[2], ansiColorYellow+"false"+ansiColorReset) {
+		t.Errorf("Inactive endpoint should be colorized: '%s'\n", lines[2])
+	}
+}
+
+func TestRenderTableHighlightChanges(t *testing.T) {
+	list := &artifactUtils.MessageStoreList{
+		MessageStores: []artifactUtils.MessageStore{
+			{Name: "OrderStore", Type: "jms-message-store", Size: 10},
+			{Name: "AuditStore", Type: "jms-message-store", Size: 3},
+		},
+	}
+	previous := map[string][]string{"OrderStore": {"OrderStore", "jms-message-store", "12"}}
+	buffer := new(bytes.Buffer)
+	err := RenderTable(buffer, list.GetColumns(), list.GetRows(), TableOptions{},
+		TableStyle{Colorize: true, Previous: previous})
+	if err != nil {
+		t.Fatal("Error rendering table: ", err)
+	}
+	lines := strings.Split(buffer.String(), "\n")
+	if strings.Contains(lines[1], ansiReverseVideo+"OrderStore") {
+		t.Errorf("Unchanged cell should not be highlighted: '%s'\n", lines[1])
+	}
+	if !strings.Contains(lines[1], ansiReverseVideo+"10") {
+		t.Errorf("Changed cell should be highlighted: '%s'\n", lines[1])
+	}
+	if !strings.Contains(lines[2], ansiReverseVideo+"AuditStore") {
+		t.Errorf("New row should be highlighted: '%s'\n", lines[2])
+	}
+}
@@ -0,0 +1,208 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+// TaskTriggerCron is the trigger type of the tasks scheduled by a cron expression
+const TaskTriggerCron = "cron"
+
+// TaskSchedule computes the fire times of a task from its cron or interval trigger. The management API does not
+// report when an interval trigger first fired, so the fire times of interval triggers are estimated from the time
+// they are computed from.
+type TaskSchedule struct {
+	Name     string
+	Cron     *QuartzCron
+	Interval time.Duration
+	// Count is the number of times an interval trigger fires, -1 if it fires until the task is undeployed
+	Count int
+	// Location is the time zone of the server, in which cron expressions are evaluated
+	Location *time.Location
+}
+
+// TaskFireTime is a time at which a task fires
+type TaskFireTime struct {
+	Task      string
+	Time      time.Time
+	Estimated bool
+	// OverlapsWith are the other tasks firing at about the same time
+	OverlapsWith []string
+}
+
+// TaskOverlap describes two tasks firing at about the same time
+type TaskOverlap struct {
+	Tasks [2]string
+	First time.Time
+	Count int
+}
+
+// Create the schedule of a task
+// @param task : task with its trigger as reported by the management API
+// @param location : time zone of the server
+// @return schedule of the task
+// @return error if the trigger is invalid
+func NewTaskSchedule(task artifactUtils.Task, location *time.Location) (TaskSchedule, error) {
+	schedule := TaskSchedule{Name: task.Name, Count: -1, Location: location}
+	if strings.EqualFold(task.Type, TaskTriggerCron) || (task.TriggerCron != "" && task.TriggerInterval == "") {
+		cron, err := ParseQuartzCron(task.TriggerCron)
+		if err != nil {
+			return schedule, err
+		}
+		schedule.Cron = cron
+		return schedule, nil
+	}
+
+	// the management API reports the interval in milliseconds
+	interval, err := strconv.ParseInt(task.TriggerInterval, 10, 64)
+	if err != nil || interval <= 0 {
+		return schedule, errors.New("invalid trigger interval '" + task.TriggerInterval + "'")
+	}
+	schedule.Interval = time.Duration(interval) * time.Millisecond
+	if task.TriggerCount != "" {
+		if schedule.Count, err = strconv.Atoi(task.TriggerCount); err != nil {
+			return schedule, errors.New("invalid trigger count '" + task.TriggerCount + "'")
+		}
+	}
+	return schedule, nil
+}
+
+// IsEstimated reports whether the fire times of the schedule are estimated
+func (schedule TaskSchedule) IsEstimated() bool {
+	return schedule.Cron == nil
+}
+
+// Describe the trigger of the schedule, such as cron 0 0 * * * ? or every 5s, 10 times
+func (schedule TaskSchedule) String() string {
+	if schedule.Cron != nil {
+		return "cron " + schedule.Cron.String()
+	}
+	description := "every " + schedule.Interval.String()
+	if schedule.Count >= 0 {
+		description += ", " + strconv.Itoa(schedule.Count) + " times"
+	}
+	return description
+}
+
+// Compute the fire times of the schedule after a time
+// @param from : time to compute the fire times after
+// @param until : time to compute the fire times before, zero for no limit
+// @param limit : maximum number of fire times
+// @return fire times in the location of from
+func (schedule TaskSchedule) FireTimes(from, until time.Time, limit int) []time.Time {
+	var times []time.Time
+	next := from
+	for len(times) < limit {
+		if schedule.Cron != nil {
+			var found bool
+			if next, found = schedule.Cron.Next(next.In(schedule.Location)); !found {
+				break
+			}
+			next = next.In(from.Location())
+		} else {
+			// the remaining count of an interval trigger is unknown, it fires at most count times
+			if schedule.Count >= 0 && len(times) >= schedule.Count {
+				break
+			}
+			next = next.Add(schedule.Interval)
+		}
+		if !until.IsZero() && next.After(until) {
+			break
+		}
+		times = append(times, next)
+	}
+	return times
+}
+
+// Find the tasks firing within a tolerance of each other. Estimated fire times are not compared as they are not
+// known exactly, see GetEstimatedTasks. The overlapping tasks of each exact fire time are set.
+// @param fireTimes : fire times of the tasks, sorted by time
+// @param tolerance : largest time between two fire times which overlap
+// @return pairs of overlapping tasks, ordered by the first time they overlap
+func FindTaskOverlaps(fireTimes []TaskFireTime, tolerance time.Duration) []TaskOverlap {
+	overlaps := make(map[[2]string]*TaskOverlap)
+	for i := range fireTimes {
+		for j := i + 1; j < len(fireTimes) && fireTimes[j].Time.Sub(fireTimes[i].Time) <= tolerance; j++ {
+			a, b := &fireTimes[i], &fireTimes[j]
+			if a.Task == b.Task || a.Estimated || b.Estimated {
+				continue
+			}
+			if !ContainsString(a.OverlapsWith, b.Task) {
+				a.OverlapsWith = append(a.OverlapsWith, b.Task)
+			}
+			if !ContainsString(b.OverlapsWith, a.Task) {
+				b.OverlapsWith = append(b.OverlapsWith, a.Task)
+			}
+			key := [2]string{a.Task, b.Task}
+			if key[0] > key[1] {
+				key[0], key[1] = key[1], key[0]
+			}
+			if overlap, found := overlaps[key]; found {
+				overlap.Count++
+			} else {
+				overlaps[key] = &TaskOverlap{Tasks: key, First: a.Time, Count: 1}
+			}
+		}
+	}
+
+
+	var result []TaskOverlap
+	for _, overlap := range overlaps {
+		result = append(result, *overlap)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].First.Equal(result[j].First) {
+			return result[i].First.Before(result[j].First)
+		}
+		return result[i].Tasks[0]+" "+result[i].Tasks[1] < result[j].Tasks[0]+" "+result[j].Tasks[1]
+	})
+	return result
+}
+
+// Get the tasks whose fire times are estimated, which cannot be compared with the fire times of the other tasks
+// @param fireTimes : fire times of the tasks, sorted by time
+// @return names of the tasks in the order in which they first fire
+func GetEstimatedTasks(fireTimes []TaskFireTime) []string {
+	var tasks []string
+	for _, fireTime := range fireTimes {
+		if fireTime.Estimated && !ContainsString(tasks, fireTime.Task) {
+			tasks = append(tasks, fireTime.Task)
+		}
+	}
+	return tasks
+}
+
+// Load a time zone by its IANA name, such as Europe/Berlin, or the local time zone for an empty name
+func LoadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.Local, nil
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.New("unknown time zone '" + name + "'")
+	}
+	return location, nil
+}
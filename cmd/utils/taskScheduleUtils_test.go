@@ -0,0 +1,107 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+func formatFireTimes(times []time.Time) string {
+	var formatted []string
+	for _, fireTime := range times {
+		formatted = append(formatted, fireTime.Format("15:04:05"))
+	}
+	return strings.Join(formatted, " ")
+}
+
+func TestTaskScheduleFireTimes(t *testing.T) {
+	from := time.Date(2020, time.January, 15, 10, 0, 0, 0, time.UTC)
+	schedule, err := NewTaskSchedule(artifactUtils.Task{Name: "PollTask", Type: "simple", TriggerCount: "3",
+		TriggerInterval: "20000"}, time.UTC)
+	if err != nil {
+		t.Fatal("Error creating the schedule: ", err)
+	}
+	AssertEqual(t, true, schedule.IsEstimated())
+	AssertEqual(t, "every 20s, 3 times", schedule.String())
+	AssertEqual(t, "10:00:20 10:00:40 10:01:00", formatFireTimes(schedule.FireTimes(from, time.Time{}, 10)))
+	AssertEqual(t, "10:00:20 10:00:40", formatFireTimes(schedule.FireTimes(from, from.Add(50*time.Second), 10)))
+
+	schedule, err = NewTaskSchedule(artifactUtils.Task{Name: "CleanupTask", Type: "cron",
+		TriggerCron: "0 0/15 * * * ?"}, time.UTC)
+	if err != nil {
+		t.Fatal("Error creating the schedule: ", err)
+	}
+	AssertEqual(t, false, schedule.IsEstimated())
+	AssertEqual(t, "cron 0 0/15 * * * ?", schedule.String())
+	AssertEqual(t, "10:15:00 10:30:00", formatFireTimes(schedule.FireTimes(from, time.Time{}, 2)))
+
+	// cron expressions are evaluated in the time zone of the server
+	schedule, _ = NewTaskSchedule(artifactUtils.Task{Name: "ReportTask", Type: "cron",
+		TriggerCron: "0 0 12 * * ?"}, time.FixedZone("UTC+2", 2*60*60))
+	AssertEqual(t, "10:00:00", formatFireTimes(schedule.FireTimes(from, time.Time{}, 1)))
+
+	if _, err = NewTaskSchedule(artifactUtils.Task{Name: "BrokenTask", TriggerInterval: "soon"}, time.UTC); err == nil {
+		t.Error("Expected an error for an invalid interval")
+	}
+	if _, err = NewTaskSchedule(artifactUtils.Task{Name: "BrokenTask", Type: "cron",
+		TriggerCron: "0 0 12"}, time.UTC); err == nil {
+		t.Error("Expected an error for an invalid cron expression")
+	}
+}
+
+func TestFindTaskOverlaps(t *testing.T) {
+	base := time.Date(2020, time.January, 15, 10, 0, 0, 0, time.UTC)
+	fireTimes := []TaskFireTime{
+		{Task: "CleanupTask", Time: base},
+		{Task: "ReportTask", Time: base.Add(30 * time.Second)},
+		{Task: "PollTask", Time: base.Add(40 * time.Second), Estimated: true},
+		{Task: "CleanupTask", Time: base.Add(15 * time.Minute)},
+		{Task: "ReportTask", Time: base.Add(15 * time.Minute)},
+		{Task: "BackupTask", Time: base.Add(15*time.Minute + 50*time.Second)},
+		{Task: "ReportTask", Time: base.Add(20 * time.Minute)},
+	}
+	overlaps := FindTaskOverlaps(fireTimes, time.Minute)
+	AssertEqual(t, 3, len(overlaps))
+	AssertEqual(t, "CleanupTask ReportTask", strings.Join(overlaps[0].Tasks[:], " "))
+	AssertEqual(t, 2, overlaps[0].Count)
+	AssertEqual(t, base, overlaps[0].First)
+	AssertEqual(t, "BackupTask CleanupTask", strings.Join(overlaps[1].Tasks[:], " "))
+	AssertEqual(t, "BackupTask ReportTask", strings.Join(overlaps[2].Tasks[:], " "))
+	// the estimated task is not paired with the other tasks, but listed once
+	AssertEqual(t, "PollTask", strings.Join(GetEstimatedTasks(fireTimes), " "))
+	AssertEqual(t, "ReportTask", strings.Join(fireTimes[0].OverlapsWith, " "))
+	AssertEqual(t, "", strings.Join(fireTimes[2].OverlapsWith, " "))
+	AssertEqual(t, "CleanupTask ReportTask", strings.Join(fireTimes[5].OverlapsWith, " "))
+	AssertEqual(t, "", strings.Join(fireTimes[6].OverlapsWith, " "))
+}
+
+func TestLoadTimeZone(t *testing.T) {
+	location, err := LoadTimeZone("")
+	if err != nil {
+		t.Fatal("Error loading the local time zone: ", err)
+	}
+	AssertEqual(t, time.Local, location)
+	if _, err = LoadTimeZone("Mars/Olympus"); err == nil {
+		t.Error("Expected an error for an unknown time zone")
+	}
+}
@@ -0,0 +1,138 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"strings"
+)
+
+// Artifact properties which are enabled and disabled
+const ArtifactPropertyTracing = "tracing"
+const ArtifactPropertyStatistics = "statistics"
+
+// Values of the artifact properties which are enabled and disabled
+const PropertyValueEnable = "enable"
+const PropertyValueDisable = "disable"
+
+// keys of the artifact properties in the payloads of the management API
+var artifactPropertyKeys = map[string]string{
+	ArtifactPropertyTracing:    "trace",
+	ArtifactPropertyStatistics: "statistics",
+}
+
+// ArtifactReference names an artifact of a type, given as [type]/[name] such as api/OrderAPI
+type ArtifactReference struct {
+	ArtifactType ArtifactType
+	Name         string
+}
+
+// String returns the reference in the form it is parsed from
+func (reference ArtifactReference) String() string {
+	return reference.ArtifactType.Name + "/" + reference.Name
+}
+
+// Parse a reference to an artifact of a type which supports statistics and tracing
+// @param reference : [type]/[name], where the type is a name or an alias of an artifact type such as proxy
+// @return referenced artifact
+// @return error if the reference is malformed or the type does not support statistics and tracing
+func ParseTraceableArtifactReference(reference string) (ArtifactReference, error) {
+	i := strings.Index(reference, "/")
+	if i <= 0 || i == len(reference)-1 {
+		return ArtifactReference{}, errors.New("invalid artifact '" + reference + "', expected [type]/[name] " +
+			"such as api/OrderAPI")
+	}
+	artifactType, err := GetArtifactType(reference[:i])
+	if err != nil {
+		return ArtifactReference{}, err
+	}
+	if !artifactType.Traceable {
+		return ArtifactReference{}, errors.New("statistics and tracing of " + artifactType.Name +
+			" artifacts cannot be changed. Supported types: " + strings.Join(GetTraceableArtifactTypeNames(), ", "))
+	}
+	return ArtifactReference{ArtifactType: artifactType, Name: reference[i+1:]}, nil
+}
+
+// GetTraceableArtifactTypeNames returns the names of the artifact types which support statistics and tracing
+func GetTraceableArtifactTypeNames() []string {
+	var names []string
+	for _, artifactType := range ArtifactTypes {
+		if artifactType.Traceable {
+			names = append(names, artifactType.Name)
+		}
+	}
+	return names
+}
+
+// Enable or disable statistics or tracing of an artifact
+// @param remote : Micro Integrator to update the artifact of
+// @param artifactType : type of the artifact
+// @param name : name of the artifact
+// @param property : tracing or statistics
+// @param value : enable or disable
+// @return message of the server
+// @return error if the property or the value is invalid or the update failed
+func UpdateArtifactProperty(remote Remote, artifactType ArtifactType, name, property, value string) (string, error) {
+	key, found := artifactPropertyKeys[property]
+	if !found || !artifactType.Traceable {
+		return "", errors.New(property + " of " + artifactType.Name + " artifacts cannot be changed")
+	}
+	if err := CheckPropertyValue(property, value); err != nil {
+		return "", err
+	}
+	return updateArtifact(remote, artifactType, map[string]string{"name": name, key: value})
+}
+
+// CheckPropertyValue checks that a value of statistics or tracing is enable or disable
+func CheckPropertyValue(property, value string) error {
+	if value != PropertyValueEnable && value != PropertyValueDisable {
+		return errors.New("invalid value '" + value + "' for " + property + ", expected " + PropertyValueEnable +
+			" or " + PropertyValueDisable)
+	}
+	return nil
+}
+
+// Select an artifact and the artifacts it uses directly or indirectly which support statistics and tracing, such
+// as the sequences and endpoints called by an API. Artifacts which are used but not defined are skipped.
+// @param graph : dependency graph of the deployment
+// @param start : artifact the flow starts at
+// @return artifacts of the flow, starting with the given artifact followed by the others ordered by type and name
+// @return error if the artifact is not in the graph
+func SelectFlowArtifacts(graph DependencyGraph, start ArtifactReference) ([]ArtifactReference, error) {
+	var startNode *GraphNode
+	for i, node := range graph.Nodes {
+		if artifactType, err := GetArtifactType(node.Kind); err == nil &&
+			artifactType.Name == start.ArtifactType.Name && node.Name == start.Name {
+			startNode = &graph.Nodes[i]
+			break
+		}
+	}
+	if startNode == nil || !startNode.Defined {
+		return nil, errors.New(start.String() + " is not deployed")
+	}
+
+	flow := []ArtifactReference{start}
+	for _, node := range graph.Dependencies(startNode.Id) {
+		artifactType, err := GetArtifactType(node.Kind)
+		if err == nil && artifactType.Traceable && node.Defined {
+			flow = append(flow, ArtifactReference{ArtifactType: artifactType, Name: node.Name})
+		}
+	}
+	return flow, nil
+}
@@ -0,0 +1,119 @@
+/*
+* Copyright (c) 2019, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+* WSO2 Inc. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestParseTraceableArtifactReference(t *testing.T) {
+	reference, err := ParseTraceableArtifactReference("proxy/StockProxy")
+	if err != nil {
+		t.Fatal("Error parsing the reference: ", err)
+	}
+	AssertEqual(t, "proxyservice", reference.ArtifactType.Name)
+	AssertEqual(t, "StockProxy", reference.Name)
+	AssertEqual(t, "proxyservice/StockProxy", reference.String())
+
+	for _, invalid := range []string{"OrderAPI", "api/", "/OrderAPI", "task/CleanupTask", "widget/Order"} {
+		if _, err = ParseTraceableArtifactReference(invalid); err == nil {
+			t.Error("Expected an error for the reference " + invalid)
+		}
+	}
+}
+
+func TestSelectFlowArtifacts(t *testing.T) {
+	files := map[string]string{
+		"sequences/Missing.xml": `<sequence name="Missing"><call><endpoint key="NoEP"/></call></sequence>`,
+	}
+	for file, content := range graphTestFiles {
+		files[file] = content
+	}
+	graph := buildDirectoryGraph(t, files)
+
+	api, _ := ParseTraceableArtifactReference("api/OrderAPI")
+	flow, err := SelectFlowArtifacts(graph, api)
+	if err != nil {
+		t.Fatal("Error selecting the flow: ", err)
+	}
+	var ids []string
+	for _, artifact := range flow {
+		ids = append(ids, artifact.String())
+	}
+	AssertEqual(t, "api/OrderAPI, endpoint/StockEP, sequence/OrderSeq", strings.Join(ids, ", "))
+
+	inbound, _ := ParseTraceableArtifactReference("inbound/OrderFiles")
+	if flow, err = SelectFlowArtifacts(graph, inbound); err != nil {
+		t.Fatal("Error selecting the flow: ", err)
+	}
+	AssertEqual(t, 3, len(flow))
+
+	// the endpoint is used but not defined
+	sequence, _ := ParseTraceableArtifactReference("sequence/Missing")
+	if flow, err = SelectFlowArtifacts(graph, sequence); err != nil {
+		t.Fatal("Error selecting the flow: ", err)
+	}
+	AssertEqual(t, 1, len(flow))
+
+	missing, _ := ParseTraceableArtifactReference("endpoint/NoEP")
+	if _, err = SelectFlowArtifacts(graph, missing); err == nil {
+		t.Error("Expected an error for an artifact which is not deployed")
+	}
+}
+
+func TestUpdateArtifactProperty(t *testing.T) {
+	var requests []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		w.Header().Set(HeaderContentType, HeaderValueApplicationJSON)
+		w.Write([]byte(`{"Message": "Enabled tracing for ('OrderAPI')"}`))
+	}))
+	defer server.Close()
+	serverUrl, _ := url.Parse(server.URL)
+	remote := Remote{Url: serverUrl.Hostname(), Port: serverUrl.Port(), AccessToken: "token"}
+
+	apiType, _ := GetArtifactType("api")
+	message, err := UpdateArtifactProperty(remote, apiType, "OrderAPI", ArtifactPropertyTracing, PropertyValueEnable)
+	if err != nil {
+		t.Fatal("Error updating tracing: ", err)
+	}
+	AssertEqual(t, "Enabled tracing for ('OrderAPI')", message)
+	_, err = UpdateArtifactProperty(remote, apiType, "OrderAPI", ArtifactPropertyStatistics, PropertyValueDisable)
+	if err != nil {
+		t.Fatal("Error updating statistics: ", err)
+	}
+	AssertEqual(t, "POST /management/apis {\"name\":\"OrderAPI\",\"trace\":\"enable\"}\n"+
+		"POST /management/apis {\"name\":\"OrderAPI\",\"statistics\":\"disable\"}", strings.Join(requests, "\n"))
+
+	if _, err = UpdateArtifactProperty(remote, apiType, "OrderAPI", ArtifactPropertyTracing, "on"); err == nil {
+		t.Error("Expected an error for an invalid value")
+	}
+	taskType, _ := GetArtifactType("task")
+	if _, err = UpdateArtifactProperty(remote, taskType, "CleanupTask", ArtifactPropertyTracing,
+		PropertyValueEnable); err == nil {
+		t.Error("Expected an error for a type without tracing")
+	}
+	AssertEqual(t, 2, len(requests))
+}
@@ -32,10 +32,13 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 
 	"github.com/olekukonko/tablewriter"
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
 	"golang.org/x/crypto/ssh/terminal"
 	"github.com/magiconair/properties"
 	"gopkg.in/resty.v1"
@@ -97,6 +100,27 @@ func InvokeDELETERequest(url string, headers map[string]string) (*resty.Response
 	return resp, err
 }
 
+// Invoke http-post request uploading a file as multipart form data using go-resty
+func InvokeMultipartPOSTRequest(url string, headers map[string]string, fieldName, filePath string) (*resty.Response,
+	error) {
+
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+
+	if headers[HeaderAuthorization] == "" {
+		headers[HeaderAuthorization] = HeaderValueAuthPrefixBearer + " " +
+			RemoteConfigData.Remotes[RemoteConfigData.CurrentRemote].AccessToken
+	}
+	// the boundary is added to the content type by resty
+	headers[HeaderContentType] = HeaderValueMultiPartFormData
+
+	AllowInsecureSSLConnection()
+	resp, err := resty.R().SetHeaders(headers).SetFile(fieldName, filePath).Post(url)
+
+	return resp, err
+}
+
 func PromptForUsername() string {
 	reader := bufio.NewReader(os.Stdin)
 
@@ -143,8 +167,13 @@ func PrintList(list []string) {
 	}
 }
 
+var insecureSSLConnection sync.Once
+
+// the TLS configuration is set only once so that requests can be invoked concurrently
 func AllowInsecureSSLConnection() {
-	resty.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+	insecureSSLConnection.Do(func() {
+		resty.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+	})
 }
 
 // Unmarshal Data from the response to the respective struct
@@ -277,7 +306,16 @@ func PutQueryParamsToMap(paramMap map[string]string, key string, value string) m
 	return paramMap
 }
 
+// help of the global --dry-run flag, only listed by the commands sending requests to a remote
+const dryRunFlagHelp = "      --dry-run\t\tPrint the requests changing the server instead of sending them\n"
+
 func GetCmdFlags(cmd string) string {
+	return GetOfflineCmdFlags(cmd) + dryRunFlagHelp
+}
+
+// GetOfflineCmdFlags returns the flags of a command which does not send requests to a remote, such as one
+// managing local files
+func GetOfflineCmdFlags(cmd string) string {
 	var showCmdFlags = "Flags:\n" +
 		"  -h, --help\t\tHelp for " + cmd + "\n" +
 		"Global Flags:\n" +
@@ -285,6 +323,71 @@ func GetCmdFlags(cmd string) string {
 	return showCmdFlags
 }
 
+// GetConfirmCmdFlags returns the flags of a command which asks for confirmation before changing the server
+func GetConfirmCmdFlags(cmd string) string {
+	return GetOfflineConfirmCmdFlags(cmd) + dryRunFlagHelp
+}
+
+// GetOfflineConfirmCmdFlags returns the flags of a command which asks for confirmation before changing local files
+func GetOfflineConfirmCmdFlags(cmd string) string {
+	var confirmCmdFlags = "Flags:\n" +
+		"  -h, --help\t\tHelp for " + cmd + "\n" +
+		"  -y, --yes\t\tSkip the confirmation prompt, needed when stdin is not a terminal\n" +
+		"Global Flags:\n" +
+		"  -v, --verbose\t\tEnable verbose mode\n"
+	return confirmCmdFlags
+}
+
+// GetStateUpdateCmdFlags returns the flags of a command which updates the states of the artifacts of a list
+func GetStateUpdateCmdFlags(cmd string, list artifactUtils.Table) string {
+	return getArtifactUpdateCmdFlags(cmd, list,
+		"  -y, --yes\t\tSkip the confirmation prompt, needed when stdin is not a terminal\n")
+}
+
+// GetPropertyUpdateCmdFlags returns the flags of a command enabling and disabling statistics and tracing
+func GetPropertyUpdateCmdFlags(cmd string, list artifactUtils.Table) string {
+	return getArtifactUpdateCmdFlags(cmd, list, "")
+}
+
+func getArtifactUpdateCmdFlags(cmd string, list artifactUtils.Table, flags string) string {
+	var stateUpdateCmdFlags = "Flags:\n" +
+		"  -h, --help\t\tHelp for " + cmd + "\n" +
+		flags +
+		"      --filter\t\tSelect the artifacts to update by [field][operator][value]. Can be repeated\n" +
+		"\t\t\tOperators: = != ~ !~ > >= < <=  (~ is a case insensitive regex match)\n" +
+		"\t\t\tFields: " + strings.Join(GetFilterFields(list), ", ") + "\n" +
+		"      --from-file\tFile listing the names of the artifacts to update, one per line\n" +
+		"Global Flags:\n" +
+		"  -v, --verbose\t\tEnable verbose mode\n" +
+		dryRunFlagHelp
+	return stateUpdateCmdFlags
+}
+
+func GetListCmdFlags(cmd string, list artifactUtils.Table) string {
+	return GetListCmdFlagsWith(cmd, list, "")
+}
+
+// GetListCmdFlagsWith returns the flags of a list command followed by the given flags of the command
+func GetListCmdFlagsWith(cmd string, list artifactUtils.Table, flags string) string {
+	var showCmdFlags = "Flags:\n" +
+		"  -h, --help\t\tHelp for " + cmd + "\n" +
+		"      --filter\t\tFilter the list by [field][operator][value]. Can be repeated\n" +
+		"\t\t\tOperators: = != ~ !~ > >= < <=  (~ is a case insensitive regex match)\n" +
+		"\t\t\tFields: " + strings.Join(GetFilterFields(list), ", ") + "\n" +
+		"      --columns\t\tComma separated list of columns to print\n" +
+		"      --sort-by\t\tColumn to sort the list by. Prefix with '-' to sort in descending order\n" +
+		"      --no-headers\tDo not print the column headers\n" +
+		"      --wide\t\tPrint all the columns without truncating values\n" +
+		"      --watch\t\tRe-fetch and redraw the output periodically. Changed values are highlighted\n" +
+		"      --interval\tTime between two fetches of --watch (default " + DefaultWatchInterval.String() + ")\n" +
+		"      --until\t\tStop watching once every listed artifact satisfies the given condition, e.g. size=0\n" +
+		flags +
+		"Global Flags:\n" +
+		"  -v, --verbose\t\tEnable verbose mode\n" +
+		dryRunFlagHelp
+	return showCmdFlags
+}
+
 func GetCmdUsage(program, cmd, subcmd, arg string) string {
 	var showCmdUsage = "Usage:\n" +
 		"  " + program + " " + cmd + " " + subcmd + "\n" +
@@ -343,8 +446,7 @@ func GetRESTAPIBase() string {
 
 	var restAPIBase string
 	if RemoteConfigData.CurrentRemote != "" {
-		restAPIBase = HTTPSProtocol + RemoteConfigData.Remotes[RemoteConfigData.CurrentRemote].Url + ":" +
-			RemoteConfigData.Remotes[RemoteConfigData.CurrentRemote].Port + "/" + Context + "/"
+		restAPIBase = GetRemoteRESTAPIBase(RemoteConfigData.Remotes[RemoteConfigData.CurrentRemote])
 	} else {
 		// this cannot happen usually
 		errMessage := `micro integrator is not specified. Please run "` + ProjectName + ` remote" command`
@@ -354,6 +456,46 @@ func GetRESTAPIBase() string {
 	return restAPIBase
 }
 
+// Get the management API base url of a given remote
+func GetRemoteRESTAPIBase(remote Remote) string {
+	return HTTPSProtocol + remote.Url + ":" + remote.Port + "/" + Context + "/"
+}
+
+// Fetch a resource of the management API of a given remote into a struct.
+// Unlike UnmarshalData, failures are returned to the caller instead of exiting.
+// @param remote: Micro Integrator to fetch the resource from
+// @param resource: resource relative to the management API base
+// @param params: parameters for the HTTP call
+// @param model: struct object
+// @return error
+func FetchRemoteData(remote Remote, resource string, params map[string]string, model interface{}) error {
+	url := GetRemoteRESTAPIBase(remote) + resource
+	headers := make(map[string]string)
+	headers[HeaderAuthorization] = HeaderValueAuthPrefixBearer + " " + remote.AccessToken
+
+	resp, err := InvokeGETRequest(url, headers, params)
+	if err != nil {
+		return errors.New("unable to connect to " + url + ". Reason: " + err.Error())
+	}
+	Logln(LogPrefixInfo+"Response:", resp.Status())
+
+	if resp.StatusCode() == http.StatusUnauthorized {
+		return errors.New("user not logged in or session timed out at " + url)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		var data map[string]string
+		if json.Unmarshal(resp.Body(), &data) == nil && data["Error"] != "" {
+			return errors.New(resp.Status() + ": " + data["Error"])
+		}
+		return errors.New(resp.Status())
+	}
+	err = json.Unmarshal(resp.Body(), model)
+	if err != nil {
+		return errors.New("invalid JSON response from " + url + ". Reason: " + err.Error())
+	}
+	return nil
+}
+
 func UnmarshalJsonToStringMap(body []byte) map[string]string {
 	var data map[string]string
 	unmarshalError := json.Unmarshal(body, &data)
@@ -371,25 +513,6 @@ func GetTableWriter() *tablewriter.Table {
 	return table
 }
 
-func printTable(columnData []string, dataChannel <-chan []string) {
-	table := GetTableWriter()
-
-	table.Append(columnData)
-
-	for v := range dataChannel {
-		table.Append(v)
-	}
-	table.Render()
-}
-
-func PrintItemList(itemList IterableStringArray, columnData []string, emptyWarning string) {
-	if itemList.GetCount() > 0 {
-		printTable(columnData, itemList.GetDataIterator())
-	} else {
-		fmt.Println(emptyWarning)
-	}
-}
-
 func CreateKeyValuePairs(mapData map[string]string) string {
 	if len(mapData) > 0 {
 		builder := new(bytes.Buffer)
@@ -501,6 +624,94 @@ func UpdateMIEndpoint(endpointName string, intendedState string) (interface{}, e
 	return handleResponse(resp, err, url)
 }
 
+func UpdateMIInboundEndpoint(inboundEndpointName string, intendedState string) (interface{}, error) {
+	url := GetRESTAPIBase() + PrefixInboundEndpoints
+	Logln(LogPrefixInfo + "URL:", url)
+	headers := make(map[string]string)
+	body := make(map[string]string)
+	body["name"] = inboundEndpointName
+	body["status"] = intendedState
+
+	if headers[HeaderAuthorization] == "" {
+		headers[HeaderAuthorization] = HeaderValueAuthPrefixBearer + " " +
+			RemoteConfigData.Remotes[RemoteConfigData.CurrentRemote].AccessToken
+	}
+	resp, err := InvokePOSTRequest(url, headers, body)
+	return handleResponse(resp, err, url)
+}
+
+// Pause or resume a task, or trigger it to run immediately
+func UpdateMITask(taskName string, intendedState string) (interface{}, error) {
+	url := GetRESTAPIBase() + PrefixTasks
+	Logln(LogPrefixInfo + "URL:", url)
+	headers := make(map[string]string)
+	body := make(map[string]string)
+	body["name"] = taskName
+	body["status"] = intendedState
+
+	if headers[HeaderAuthorization] == "" {
+		headers[HeaderAuthorization] = HeaderValueAuthPrefixBearer + " " +
+			RemoteConfigData.Remotes[RemoteConfigData.CurrentRemote].AccessToken
+	}
+	resp, err := InvokePOSTRequest(url, headers, body)
+	return handleResponse(resp, err, url)
+}
+
+func DeployMICompositeApp(carFilePath string) (interface{}, error) {
+	url := GetRESTAPIBase() + PrefixCarbonApps
+	Logln(LogPrefixInfo + "URL:", url)
+	resp, err := InvokeMultipartPOSTRequest(url, nil, "file", carFilePath)
+	return handleResponse(resp, err, url)
+}
+
+func UndeployMICompositeApp(compositeAppName string) (interface{}, error) {
+	url := GetRESTAPIBase() + PrefixCarbonApps + "/" + compositeAppName
+	Logln(LogPrefixInfo + "URL:", url)
+	resp, err := InvokeDELETERequest(url, nil)
+	return handleResponse(resp, err, url)
+}
+
+func AddMIUser(userId, password string, isAdmin bool) error {
+	url := GetRESTAPIBase() + PrefixUsers
+	Logln(LogPrefixInfo + "URL:", url)
+	headers := make(map[string]string)
+	headers[HeaderContentType] = HeaderValueApplicationJSON
+	body := make(map[string]string)
+	body["userId"] = userId
+	body["password"] = password
+	body["isAdmin"] = strconv.FormatBool(isAdmin)
+	resp, err := InvokePOSTRequest(url, headers, body)
+	return checkResponseStatus(resp, err, url)
+}
+
+func RemoveMIUser(userId string) error {
+	url := GetRESTAPIBase() + PrefixUsers + "/" + userId
+	Logln(LogPrefixInfo + "URL:", url)
+	resp, err := InvokeDELETERequest(url, nil)
+	return checkResponseStatus(resp, err, url)
+}
+
+// check the status of a response whose body does not carry a message on success
+func checkResponseStatus(resp *resty.Response, err error, url string) error {
+	if err != nil {
+		return errors.New("unable to connect to " + url + ". Reason: " + err.Error())
+	}
+	Logln(LogPrefixInfo + "Response:", resp.Status())
+	if resp.StatusCode() == http.StatusOK {
+		return nil
+	}
+	if resp.StatusCode() == http.StatusUnauthorized {
+		return errors.New("user not logged in or session timed out at " + url)
+	}
+	// the body of an error is not always JSON, such as the page of a proxy in front of the server
+	var data map[string]string
+	json.Unmarshal(resp.Body(), &data)
+	if data["Error"] != "" {
+		return errors.New(resp.Status() + ": " + data["Error"])
+	}
+	return errors.New(resp.Status())
+}
+
 func IsValidConsoleInput(inputs map[string]string) (bool) {
 	for key, input := range inputs {
 		if len(strings.TrimSpace(input)) == 0 {
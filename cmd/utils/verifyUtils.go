@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+const VerifyStatusOK = "OK"
+const VerifyStatusMissing = "MISSING"
+const VerifyStatusFaulty = "FAULTY"
+const VerifyStatusVersionMismatch = "VERSION MISMATCH"
+const VerifyStatusInactive = "INACTIVE"
+const VerifyStatusNotVerified = "NOT VERIFIED"
+
+// VerificationResult is the state of an artifact of a Composite App archive on a Micro Integrator
+type VerificationResult struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Status  string `json:"status"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// IsFailure returns true iff the artifact is not live as expected
+func (result VerificationResult) IsFailure() bool {
+	return result.Status != VerifyStatusOK && result.Status != VerifyStatusNotVerified
+}
+
+// Verify that the Composite App of an archive is deployed and active with the same version
+// @param application : Composite App declared in the archive
+// @param compositeApps : composite apps deployed in the Micro Integrator
+// @return state of the Composite App
+func VerifyCarApplication(application CarArtifact, compositeApps *artifactUtils.CompositeAppList) VerificationResult {
+	result := VerificationResult{Type: "compositeapp", Name: application.Name, Version: application.Version,
+		Status: VerifyStatusMissing}
+	check := func(deployed []artifactUtils.CompositeAppSummary, status string) bool {
+		for _, compositeApp := range deployed {
+			if compositeApp.Name != application.Name {
+				continue
+			}
+			if compositeApp.Version != application.Version {
+				result.Status = VerifyStatusVersionMismatch
+				result.Detail = "version " + compositeApp.Version + " is deployed"
+			} else {
+				result.Status = status
+			}
+			return true
+		}
+		return false
+	}
+	if !check(compositeApps.ActiveCompositeApps, VerifyStatusOK) {
+		check(compositeApps.FaultyCompositeApps, VerifyStatusFaulty)
+	}
+	return result
+}
+
+// CarDeployment holds the state of a Micro Integrator against which the artifacts of an archive are verified
+type CarDeployment struct {
+	// Lists are the lists of the deployed artifacts keyed by artifact type name
+	Lists map[string]artifactUtils.Table
+	// Application is the result of verifying the Composite App of the archive
+	Application VerificationResult
+	// CompositeApp is the detail of the deployed Composite App, nil if it is not deployed
+	CompositeApp *artifactUtils.CompositeApp
+	// Details are the properties of the detailed views of the deployed artifacts keyed by type and name
+	Details map[string]map[string]string
+}
+
+// Fetch the detailed views of the deployed artifacts of an archive whose detail holds a version
+// @param remote : Micro Integrator the artifacts are deployed in
+// @param artifacts : artifacts bundled in the archive
+// @param lists : lists of the deployed artifacts keyed by artifact type name
+// @return properties of the detailed views keyed by type and name
+// @return error if a detailed view could not be fetched
+func FetchCarArtifactDetails(remote Remote, artifacts []CarFileArtifact,
+	lists map[string]artifactUtils.Table) (map[string]map[string]string, error) {
+
+	details := make(map[string]map[string]string)
+	for _, artifact := range artifacts {
+		artifactType, found := GetArtifactTypeOfCarType(artifact.Type)
+		if !found || artifactType.NewDetail == nil || artifact.Version == "" {
+			continue
+		}
+		if _, versioned := artifactType.NewDetail().GetProperties()["version"]; !versioned {
+			continue
+		}
+		list, found := lists[artifactType.Name]
+		if !found {
+			continue
+		}
+		if _, found = FindArtifactRow(list, artifact.Name); !found {
+			continue
+		}
+		detail := artifactType.NewDetail()
+		params := map[string]string{artifactType.DetailParam: artifact.Name}
+		if err := FetchRemoteData(remote, artifactType.Resource, params, detail); err != nil {
+			return nil, errors.New("fetching " + artifactType.Name + " " + artifact.Name + " failed: " + err.Error())
+		}
+		details[artifact.Type+"/"+artifact.Name] = detail.GetProperties()
+	}
+	return details, nil
+}
+
+// Verify that an artifact of an archive is listed by the Micro Integrator with the same version, and active if
+// it has a state
+// @param artifact : artifact bundled in the archive
+// @param deployment : state of the Micro Integrator
+// @return state of the artifact
+func VerifyCarArtifact(artifact CarFileArtifact, deployment CarDeployment) VerificationResult {
+	result := VerificationResult{Type: artifact.Type, Name: artifact.Name, Version: artifact.Version}
+	if artifact.Missing {
+		result.Status = VerifyStatusNotVerified
+		result.Detail = "metadata missing in the archive"
+		return result
+	}
+	artifactType, found := GetArtifactTypeOfCarType(artifact.Type)
+	if !found {
+		result.Status = VerifyStatusNotVerified
+		result.Detail = "not listed by the management API"
+		return result
+	}
+	list, found := deployment.Lists[artifactType.Name]
+	if !found {
+		result.Status = VerifyStatusNotVerified
+		result.Detail = artifactType.Name + " list is not available"
+		return result
+	}
+
+	row, found := FindArtifactRow(list, artifact.Name)
+	if !found {
+		if deployment.Application.Status == VerifyStatusFaulty {
+			// the artifacts of a faulty Composite App are not deployed
+			result.Status = VerifyStatusFaulty
+			result.Detail = "Composite App is faulty"
+		} else {
+			result.Status = VerifyStatusMissing
+		}
+		return result
+	}
+	deployedVersion := deployment.Details[artifact.Type+"/"+artifact.Name]["version"]
+	if deployedVersion != "" && artifact.Version != "" && deployedVersion != artifact.Version {
+		result.Status = VerifyStatusVersionMismatch
+		result.Detail = "version " + deployedVersion + " is deployed"
+		return result
+	}
+	if artifactType.ActiveCondition != "" {
+		rows, err := FilterRows(list.GetColumns(), []artifactUtils.Row{row}, []string{artifactType.ActiveCondition})
+		if err == nil && len(rows) == 0 {
+			result.Status = VerifyStatusInactive
+			return result
+		}
+	}
+	result.Status = VerifyStatusOK
+	compositeApp := deployment.CompositeApp
+	if compositeApp != nil && len(compositeApp.Artifacts) > 0 &&
+		!containsArtifact(compositeApp.Artifacts, artifact.Name) {
+		result.Detail = "deployed outside the Composite App"
+	}
+	return result
+}
+
+func containsArtifact(artifacts []artifactUtils.Artifact, name string) bool {
+	for _, artifact := range artifacts {
+		if artifact.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+func TestVerifyCarApplication(t *testing.T) {
+	compositeApps := &artifactUtils.CompositeAppList{
+		ActiveCompositeApps: []artifactUtils.CompositeAppSummary{{Name: "OrderCApp", Version: "1.0.0"}},
+		FaultyCompositeApps: []artifactUtils.CompositeAppSummary{{Name: "StockCApp", Version: "2.0.0"}},
+	}
+	verify := func(name, version string) VerificationResult {
+		return VerifyCarApplication(CarArtifact{Name: name, Version: version}, compositeApps)
+	}
+	AssertEqual(t, VerifyStatusOK, verify("OrderCApp", "1.0.0").Status)
+	AssertEqual(t, VerifyStatusVersionMismatch, verify("OrderCApp", "1.0.1").Status)
+	AssertEqual(t, VerifyStatusFaulty, verify("StockCApp", "2.0.0").Status)
+	AssertEqual(t, VerifyStatusMissing, verify("AuditCApp", "1.0.0").Status)
+}
+
+func TestVerifyCarArtifact(t *testing.T) {
+	lists := map[string]artifactUtils.Table{
+		"endpoint": &artifactUtils.EndpointList{Endpoints: []artifactUtils.EndpointSummary{
+			{Name: "OrderEP", Type: "http", Active: true}, {Name: "StockEP", Type: "http", Active: false}}},
+	}
+	verify := func(name, carType string) VerificationResult {
+		return VerifyCarArtifact(CarFileArtifact{Name: name, Version: "1.0.0", Type: carType},
+			CarDeployment{Lists: lists})
+	}
+	AssertEqual(t, VerifyStatusOK, verify("OrderEP", "endpoint").Status)
+	AssertEqual(t, VerifyStatusInactive, verify("StockEP", "endpoint").Status)
+	AssertEqual(t, VerifyStatusMissing, verify("AuditEP", "endpoint").Status)
+	AssertEqual(t, VerifyStatusNotVerified, verify("OrderAPI", "api").Status)
+	AssertEqual(t, VerifyStatusNotVerified, verify("registry", "registry").Status)
+
+	deployed := &artifactUtils.CompositeApp{Artifacts: []artifactUtils.Artifact{{Name: "StockEP", Type: "endpoint"}}}
+	result := VerifyCarArtifact(CarFileArtifact{Name: "OrderEP", Type: "endpoint"},
+		CarDeployment{Lists: lists, CompositeApp: deployed})
+	AssertEqual(t, VerifyStatusOK, result.Status)
+	AssertEqual(t, "deployed outside the Composite App", result.Detail)
+}
+
+func TestVerifyCarArtifactVersionAndFaulty(t *testing.T) {
+	lists := map[string]artifactUtils.Table{
+		"api": &artifactUtils.APIList{Apis: []artifactUtils.APISummary{{Name: "OrderAPI"}, {Name: "StockAPI"}}},
+	}
+	deployment := CarDeployment{Lists: lists, Details: map[string]map[string]string{
+		"api/OrderAPI": {"version": "1.0.1"}, "api/StockAPI": {"version": ""}}}
+	verify := func(name string) VerificationResult {
+		return VerifyCarArtifact(CarFileArtifact{Name: name, Version: "1.0.0", Type: "api"}, deployment)
+	}
+	result := verify("OrderAPI")
+	AssertEqual(t, VerifyStatusVersionMismatch, result.Status)
+	AssertEqual(t, "version 1.0.1 is deployed", result.Detail)
+	AssertEqual(t, VerifyStatusOK, verify("StockAPI").Status)
+	AssertEqual(t, VerifyStatusMissing, verify("AuditAPI").Status)
+
+	deployment.Application = VerificationResult{Status: VerifyStatusFaulty}
+	AssertEqual(t, VerifyStatusFaulty, verify("AuditAPI").Status)
+}
+
+func TestFetchCarArtifactDetails(t *testing.T) {
+	server, remote := createRemoteServer(t, map[string]string{
+		PrefixAPIs + "?apiName=OrderAPI": `{"name": "OrderAPI", "version": "1.0.1"}`,
+	})
+	defer server.Close()
+
+	lists := map[string]artifactUtils.Table{
+		"api": &artifactUtils.APIList{Apis: []artifactUtils.APISummary{{Name: "OrderAPI"}}},
+	}
+	artifacts := []CarFileArtifact{{Name: "OrderAPI", Version: "1.0.0", Type: "api"},
+		{Name: "AuditAPI", Version: "1.0.0", Type: "api"}}
+	details, err := FetchCarArtifactDetails(remote, artifacts, lists)
+	if err != nil {
+		t.Fatal("Error fetching the details: ", err)
+	}
+	AssertEqual(t, 1, len(details))
+	AssertEqual(t, "1.0.1", details["api/OrderAPI"]["version"])
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+const WaitStateExists = "exists"
+const WaitStateAbsent = "absent"
+const WaitStateActive = "active"
+const WaitStateInactive = "inactive"
+
+// WaitCondition is a readiness condition on a single artifact such as "compositeapp/OrderApp active"
+type WaitCondition struct {
+	ArtifactType ArtifactType
+	Name         string
+	State        string
+	filter       RowFilter
+}
+
+// Parse a wait condition
+// @param target : artifact given as [type]/[name]
+// @param state : exists, absent, active, inactive or a filter expression such as size=0
+// @return parsed condition
+// @return error if the target or the state is invalid
+func ParseWaitCondition(target, state string) (WaitCondition, error) {
+	parts := strings.SplitN(target, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return WaitCondition{}, errors.New("invalid artifact '" + target + "'. Expected [type]/[name]")
+	}
+	artifactType, err := GetArtifactType(parts[0])
+	if err != nil {
+		return WaitCondition{}, err
+	}
+	condition := WaitCondition{ArtifactType: artifactType, Name: parts[1], State: state}
+	columns := artifactType.NewList().GetColumns()
+
+	switch strings.ToLower(state) {
+	case WaitStateExists, WaitStateAbsent:
+		return condition, nil
+	case WaitStateActive, WaitStateInactive:
+		if artifactType.ActiveCondition == "" {
+			return WaitCondition{}, errors.New(artifactType.Name + " artifacts do not have an active state")
+		}
+		condition.filter, err = ParseRowFilter(columns, artifactType.ActiveCondition)
+	default:
+		condition.filter, err = ParseRowFilter(columns, state)
+	}
+	if err != nil {
+		return WaitCondition{}, err
+	}
+	return condition, nil
+}
+
+// IsSatisfiedBy returns true iff the condition holds for the given list of artifacts
+func (condition WaitCondition) IsSatisfiedBy(list artifactUtils.Table) bool {
+	row, found := FindArtifactRow(list, condition.Name)
+	switch strings.ToLower(condition.State) {
+	case WaitStateExists:
+		return found
+	case WaitStateAbsent:
+		return !found
+	case WaitStateInactive:
+		return found && !condition.filter.Matches(row)
+	}
+	return found && condition.filter.Matches(row)
+}
+
+func (condition WaitCondition) String() string {
+	return condition.ArtifactType.Name + "/" + condition.Name + " " + condition.State
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+func assertWaitCondition(t *testing.T, target, state string, list artifactUtils.Table, expected bool) {
+	condition, err := ParseWaitCondition(target, state)
+	if err != nil {
+		t.Fatalf("Error parsing condition '%s %s': %v\n", target, state, err)
+	}
+	if condition.IsSatisfiedBy(list) != expected {
+		t.Errorf("Expected condition '%s' to be %t\n", condition, expected)
+	}
+}
+
+func TestWaitConditionCompositeApp(t *testing.T) {
+	list := &artifactUtils.CompositeAppList{
+		ActiveCompositeApps: []artifactUtils.CompositeAppSummary{{Name: "OrderApp", Version: "1.0.0"}},
+		FaultyCompositeApps: []artifactUtils.CompositeAppSummary{{Name: "BrokenApp", Version: "1.0.0"}},
+	}
+	assertWaitCondition(t, "capp/OrderApp", "active", list, true)
+	assertWaitCondition(t, "compositeapp/BrokenApp", "active", list, false)
+	assertWaitCondition(t, "compositeapp/BrokenApp", "status=faulty", list, true)
+	assertWaitCondition(t, "compositeapp/MissingApp", "inactive", list, false)
+	assertWaitCondition(t, "compositeapp/MissingApp", "absent", list, true)
+}
+
+func TestWaitConditionEndpointAndStore(t *testing.T) {
+	endpoints := &artifactUtils.EndpointList{
+		Endpoints: []artifactUtils.EndpointSummary{{Name: "OrderEP", Active: false}},
+	}
+	assertWaitCondition(t, "endpoint/OrderEP", "active", endpoints, false)
+	assertWaitCondition(t, "endpoint/OrderEP", "inactive", endpoints, true)
+	assertWaitCondition(t, "endpoint/OrderEP", "exists", endpoints, true)
+
+	stores := &artifactUtils.MessageStoreList{
+		MessageStores: []artifactUtils.MessageStore{{Name: "OrderStore", Size: 0}},
+	}
+	assertWaitCondition(t, "messagestore/OrderStore", "size=0", stores, true)
+	assertWaitCondition(t, "messagestore/OrderStore", "size>0", stores, false)
+}
+
+func TestParseWaitConditionInvalid(t *testing.T) {
+	invalid := [][]string{{"OrderAPI", "exists"}, {"flow/OrderAPI", "exists"}, {"api/OrderAPI", "active"},
+		{"messagestore/OrderStore", "depth=0"}}
+	for _, condition := range invalid {
+		if _, err := ParseWaitCondition(condition[0], condition[1]); err == nil {
+			t.Errorf("Expected an error for condition '%s %s'\n", condition[0], condition[1])
+		}
+	}
+}
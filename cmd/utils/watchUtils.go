@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+const DefaultWatchInterval = 2 * time.Second
+
+const ansiClearScreen = "\033[H\033[2J"
+
+// WatchOptions holds the watch mode options given to the currently executing command
+type WatchOptions struct {
+	Enabled  bool
+	Interval time.Duration
+	Until    string
+}
+
+var ListWatchOptions WatchOptions
+
+// errWatchPollFailed ends a poll of the watch mode in which the command failed
+var errWatchPollFailed = errors.New("watch poll failed")
+
+type watchState struct {
+	active bool
+	// polling is set while the show command runs, so that its errors end the poll instead of the command
+	polling  bool
+	listed   bool
+	untilMet bool
+	previous map[string][]string
+}
+
+var watch watchState
+
+func isWatching() bool {
+	return watch.active
+}
+
+// record the rows printed in this poll and evaluate the --until condition against them
+func (state *watchState) update(columns []artifactUtils.Column, rows []artifactUtils.Row) error {
+	state.listed = true
+	state.previous = make(map[string][]string)
+	for _, row := range rows {
+		state.previous[cellAt(row, 0)] = row.Cells
+	}
+	if ListWatchOptions.Until == "" {
+		return nil
+	}
+	matched, err := FilterRows(columns, rows, []string{ListWatchOptions.Until})
+	if err != nil {
+		return err
+	}
+	state.untilMet = len(rows) > 0 && len(matched) == len(rows)
+	return nil
+}
+
+// Run the show command repeatedly, redrawing its output until interrupted or until the --until condition
+// is satisfied by every listed artifact
+// @param title : command line shown above the output
+// @param show : function fetching and printing the artifacts
+func WatchList(title string, show func()) {
+	if ListWatchOptions.Interval <= 0 {
+		HandleErrorAndExit("Invalid --interval.", errors.New("interval must be positive"))
+	}
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	watch = watchState{active: true}
+	isTTY := IsTerminal(os.Stdout)
+	for {
+		if isTTY {
+			fmt.Print(ansiClearScreen)
+		}
+		fmt.Printf("Every %v: %s\t%s\n\n", ListWatchOptions.Interval, title, time.Now().Format(time.RFC1123))
+		if !pollWatch(show) {
+			fmt.Println("\nRetrying in " + ListWatchOptions.Interval.String())
+		} else if ListWatchOptions.Until != "" {
+			if !watch.listed {
+				HandleErrorAndExit("--until can only be used when listing artifacts.", nil)
+			}
+			if watch.untilMet {
+				fmt.Println("\nCondition '" + ListWatchOptions.Until + "' met")
+				return
+			}
+		}
+		if !isTTY {
+			fmt.Println()
+		}
+		select {
+		case <-interrupt:
+			fmt.Println()
+			return
+		case <-time.After(ListWatchOptions.Interval):
+		}
+	}
+}
+
+// run a poll of the watch mode
+// @return false if the command failed, its error is already printed
+func pollWatch(show func()) (succeeded bool) {
+	watch.polling = true
+	defer func() {
+		watch.polling = false
+		if recovered := recover(); recovered != nil {
+			if recovered != errWatchPollFailed {
+				panic(recovered)
+			}
+			succeeded = false
+		}
+	}()
+	show()
+	return true
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/wso2/product-mi-tooling/cmd/utils/artifactUtils"
+)
+
+func TestWatchStateUntil(t *testing.T) {
+	ListWatchOptions.Until = "size=0"
+	defer func() { ListWatchOptions.Until = "" }()
+
+	list := &artifactUtils.MessageStoreList{
+		MessageStores: []artifactUtils.MessageStore{{Name: "OrderStore", Size: 4}, {Name: "AuditStore", Size: 0}},
+	}
+	state := watchState{active: true}
+	if err := state.update(list.GetColumns(), list.GetRows()); err != nil {
+		t.Fatal("Error updating watch state: ", err)
+	}
+	AssertEqual(t, false, state.untilMet)
+	AssertEqual(t, "4", state.previous["OrderStore"][2])
+
+	list.MessageStores[0].Size = 0
+	_ = state.update(list.GetColumns(), list.GetRows())
+	AssertEqual(t, true, state.untilMet)
+
+	_ = state.update(list.GetColumns(), nil)
+	AssertEqual(t, false, state.untilMet)
+}
+
+func TestPrintItemListWatchingEmptyList(t *testing.T) {
+	ListWatchOptions.Until = "size=0"
+	ListTableOptions.Filters = []string{"size>100"}
+	watch = watchState{active: true, previous: map[string][]string{"OrderStore": {"OrderStore"}}}
+	defer func() {
+		ListWatchOptions.Until = ""
+		ListTableOptions.Filters = nil
+		watch = watchState{}
+	}()
+
+	list := &artifactUtils.MessageStoreList{MessageStores: []artifactUtils.MessageStore{{Name: "OrderStore", Size: 4}}}
+	PrintItemList(list, "No message stores found")
+	AssertEqual(t, true, watch.listed)
+	AssertEqual(t, false, watch.untilMet)
+	AssertEqual(t, 0, len(watch.previous))
+}
@@ -0,0 +1,303 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"sort"
+	"strings"
+)
+
+const DiffLineSame = ' '
+const DiffLineRemoved = '-'
+const DiffLineAdded = '+'
+
+const xmlIndent = "  "
+
+// maximum number of cells of the longest common subsequence table of DiffLines. Beyond it the differing lines
+// are reported as removed and added as a whole instead of allocating a table quadratic in the number of lines
+var maxDiffCells = 1 << 22
+
+// DiffLine is a line of a line based diff
+type DiffLine struct {
+	Op   byte
+	Text string
+}
+
+func (line DiffLine) String() string {
+	return string(line.Op) + " " + line.Text
+}
+
+// element of a parsed document with its position
+type xmlNode struct {
+	// Name is the local name of the element
+	Name string
+	// Prefix is the namespace prefix of the element as written
+	Prefix string
+	// Attributes are the values of the attributes by local name, without the namespace declarations
+	Attributes map[string]string
+	// RawAttributes are all the attributes with their prefixes as written, in document order
+	RawAttributes []xml.Attr
+	Children      []*xmlNode
+	// Text is the character data of the element with each run trimmed of surrounding whitespace
+	Text   string
+	Line   int
+	Column int
+}
+
+// parse a document into a tree of elements with their positions, checking that the whole document is well formed.
+// Namespace prefixes are kept as written instead of being replaced by the namespace URLs
+// @param content : XML document
+// @return unnamed node holding the top level elements as children
+// @return error if the document is not well formed
+func parseXMLDocument(content []byte) (*xmlNode, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	document := &xmlNode{}
+	stack := []*xmlNode{document}
+	line, lineStart, scanned := 1, 0, 0
+	for {
+		offset := int(decoder.InputOffset())
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for ; scanned < offset; scanned++ {
+			if content[scanned] == '\n' {
+				line++
+				lineStart = scanned + 1
+			}
+		}
+		current := stack[len(stack)-1]
+		switch token := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{Name: token.Name.Local, Prefix: token.Name.Space, Attributes: make(map[string]string),
+				RawAttributes: token.Attr, Line: line, Column: offset - lineStart + 1}
+			for _, attribute := range token.Attr {
+				if attribute.Name.Space != "xmlns" && attribute.Name.Local != "xmlns" {
+					node.Attributes[attribute.Name.Local] = attribute.Value
+				}
+			}
+			current.Children = append(current.Children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			// raw tokens are not checked against their start elements
+			if len(stack) == 1 {
+				return nil, &xml.SyntaxError{Msg: "unexpected end element </" + qualifiedXMLName(token.Name) + ">",
+					Line: line}
+			}
+			if current.qualifiedName() != qualifiedXMLName(token.Name) {
+				return nil, &xml.SyntaxError{Msg: "element <" + current.qualifiedName() + "> closed by </" +
+					qualifiedXMLName(token.Name) + ">", Line: line}
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			current.Text += strings.TrimSpace(string(token))
+		}
+	}
+	if len(stack) > 1 {
+		return nil, &xml.SyntaxError{Msg: "unexpected EOF", Line: line + bytes.Count(content[scanned:], []byte("\n"))}
+	}
+	return document, nil
+}
+
+// name with its namespace prefix, if any
+func qualifiedXMLName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+func (node *xmlNode) qualifiedName() string {
+	return qualifiedXMLName(xml.Name{Space: node.Prefix, Local: node.Name})
+}
+
+// Normalize an XML document so that documents which differ only in whitespace, attribute order or comments
+// are equal. Each element is printed on its own indented line with sorted attributes. Namespace prefixes are
+// kept as written, so elements of different namespaces with the same local name stay different.
+// @param data : XML document
+// @return lines of the normalized document
+// @return error if the document is not well formed
+func NormalizeXML(data []byte) ([]string, error) {
+	document, err := parseXMLDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	for _, node := range document.Children {
+		lines = node.appendNormalizedLines(lines, "")
+	}
+	return lines, nil
+}
+
+func (node *xmlNode) appendNormalizedLines(lines []string, indent string) []string {
+	var attributes []string
+	for _, attribute := range node.RawAttributes {
+		attributes = append(attributes, qualifiedXMLName(attribute.Name)+"=\""+escapeXMLText(attribute.Value)+"\"")
+	}
+	sort.Strings(attributes)
+	name := node.qualifiedName()
+	start := "<" + strings.Join(append([]string{name}, attributes...), " ")
+	if len(node.Children) == 0 {
+		if node.Text == "" {
+			return append(lines, indent+start+"/>")
+		}
+		return append(lines, indent+start+">"+escapeXMLText(node.Text)+"</"+name+">")
+	}
+	lines = append(lines, indent+start+">")
+	if node.Text != "" {
+		lines = append(lines, indent+xmlIndent+escapeXMLText(node.Text))
+	}
+	for _, child := range node.Children {
+		lines = child.appendNormalizedLines(lines, indent+xmlIndent)
+	}
+	return append(lines, indent+"</"+name+">")
+}
+
+func escapeXMLText(text string) string {
+	buffer := new(bytes.Buffer)
+	_ = xml.EscapeText(buffer, []byte(text))
+	return buffer.String()
+}
+
+// Compute the difference between two sequences of lines using their longest common subsequence. The common
+// first and last lines are matched directly, and when the lines left in between are too many to compare they
+// are all marked as removed and added.
+// @param old : lines before the change
+// @param new : lines after the change
+// @return all the lines of both sequences marked as same, removed or added
+func DiffLines(old, new []string) []DiffLine {
+	prefix := 0
+	for prefix < len(old) && prefix < len(new) && old[prefix] == new[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(old)-prefix && suffix < len(new)-prefix &&
+		old[len(old)-1-suffix] == new[len(new)-1-suffix] {
+		suffix++
+	}
+
+	var lines []DiffLine
+	for _, text := range old[:prefix] {
+		lines = append(lines, DiffLine{Op: DiffLineSame, Text: text})
+	}
+	lines = append(lines, diffLinesLCS(old[prefix:len(old)-suffix], new[prefix:len(new)-suffix])...)
+	for _, text := range old[len(old)-suffix:] {
+		lines = append(lines, DiffLine{Op: DiffLineSame, Text: text})
+	}
+	return lines
+}
+
+func diffLinesLCS(old, new []string) []DiffLine {
+	var lines []DiffLine
+	if (len(old)+1)*(len(new)+1) > maxDiffCells {
+		for _, text := range old {
+			lines = append(lines, DiffLine{Op: DiffLineRemoved, Text: text})
+		}
+		for _, text := range new {
+			lines = append(lines, DiffLine{Op: DiffLineAdded, Text: text})
+		}
+		return lines
+	}
+
+	// common[i][j] is the length of the longest common subsequence of old[i:] and new[j:]
+	common := make([][]int, len(old)+1)
+	for i := range common {
+		common[i] = make([]int, len(new)+1)
+	}
+	for i := len(old) - 1; i >= 0; i-- {
+		for j := len(new) - 1; j >= 0; j-- {
+			if old[i] == new[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else if common[i+1][j] >= common[i][j+1] {
+				common[i][j] = common[i+1][j]
+			} else {
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(old) && j < len(new) {
+		if old[i] == new[j] {
+			lines = append(lines, DiffLine{Op: DiffLineSame, Text: old[i]})
+			i++
+			j++
+		} else if common[i+1][j] >= common[i][j+1] {
+			lines = append(lines, DiffLine{Op: DiffLineRemoved, Text: old[i]})
+			i++
+		} else {
+			lines = append(lines, DiffLine{Op: DiffLineAdded, Text: new[j]})
+			j++
+		}
+	}
+	for ; i < len(old); i++ {
+		lines = append(lines, DiffLine{Op: DiffLineRemoved, Text: old[i]})
+	}
+	for ; j < len(new); j++ {
+		lines = append(lines, DiffLine{Op: DiffLineAdded, Text: new[j]})
+	}
+	return lines
+}
+
+// Keep only the changed lines of a diff and the given number of unchanged lines around them
+// @param lines : full diff
+// @param context : number of unchanged lines to keep before and after each change
+// @return hunks of the diff, each a sequence of consecutive lines
+func GetDiffHunks(lines []DiffLine, context int) [][]DiffLine {
+	var hunks [][]DiffLine
+	var hunk []DiffLine
+	lastChange := -1
+	for i, line := range lines {
+		if line.Op == DiffLineSame {
+			continue
+		}
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		if lastChange >= 0 && start <= lastChange+context+1 {
+			// close enough to the previous change to extend its hunk
+			hunk = append(hunk, lines[lastChange+1:i+1]...)
+		} else {
+			if hunk != nil {
+				hunks = append(hunks, appendDiffContext(hunk, lines, lastChange, context))
+			}
+			hunk = append([]DiffLine{}, lines[start:i+1]...)
+		}
+		lastChange = i
+	}
+	if hunk != nil {
+		hunks = append(hunks, appendDiffContext(hunk, lines, lastChange, context))
+	}
+	return hunks
+}
+
+func appendDiffContext(hunk, lines []DiffLine, lastChange, context int) []DiffLine {
+	end := lastChange + 1 + context
+	if end > len(lines) {
+		end = len(lines)
+	}
+	return append(hunk, lines[lastChange+1:end]...)
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2020, WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeXMLIgnoresFormatting(t *testing.T) {
+	first, err := NormalizeXML([]byte(`<sequence name="OrderSeq" trace="disable">
+		<!-- forward the order -->
+		<send><endpoint key="OrderEP"/></send>
+	</sequence>`))
+	if err != nil {
+		t.Fatal("Error normalizing XML: ", err)
+	}
+	second, err := NormalizeXML([]byte(`<sequence trace="disable"   name="OrderSeq"><send>
+		<endpoint key="OrderEP"></endpoint></send></sequence>`))
+	if err != nil {
+		t.Fatal("Error normalizing XML: ", err)
+	}
+	AssertEqual(t, strings.Join(first, "\n"), strings.Join(second, "\n"))
+	AssertEqual(t, `<sequence name="OrderSeq" trace="disable">`, first[0])
+	AssertEqual(t, `    <endpoint key="OrderEP"/>`, first[2])
+}
+
+func TestNormalizeXMLInvalid(t *testing.T) {
+	if _, err := NormalizeXML([]byte(`<sequence><send></sequence>`)); err == nil {
+		t.Error("Expected an error for a malformed document")
+	}
+}
+
+func TestNormalizeXMLKeepsNamespacePrefixes(t *testing.T) {
+	lines, err := NormalizeXML([]byte(`<payloadFactory xmlns:m0="http://services.samples">
+		<format><m0:getQuote m0:symbol="WSO2"/></format></payloadFactory>`))
+	if err != nil {
+		t.Fatal("Error normalizing XML: ", err)
+	}
+	AssertEqual(t, `<payloadFactory xmlns:m0="http://services.samples">`, lines[0])
+	AssertEqual(t, `    <m0:getQuote m0:symbol="WSO2"/>`, lines[2])
+
+	if _, err := NormalizeXML([]byte(`<m0:format xmlns:m0="urn:a"></m1:format>`)); err == nil {
+		t.Error("Expected an error for an end element of another prefix")
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	lines := DiffLines([]string{"a", "b", "c"}, []string{"a", "c", "d"})
+	var result []string
+	for _, line := range lines {
+		result = append(result, line.String())
+	}
+	AssertEqual(t, "  a|- b|  c|+ d", strings.Join(result, "|"))
+}
+
+func TestGetDiffHunks(t *testing.T) {
+	old := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"}
+	new := []string{"1", "x", "3", "4", "5", "6", "7", "8", "9", "y"}
+	hunks := GetDiffHunks(DiffLines(old, new), 1)
+	AssertEqual(t, 2, len(hunks))
+	AssertEqual(t, "  1", hunks[0][0].String())
+	AssertEqual(t, "  3", hunks[0][len(hunks[0])-1].String())
+	AssertEqual(t, "+ y", hunks[1][len(hunks[1])-1].String())
+
+	AssertEqual(t, 1, len(GetDiffHunks(DiffLines(old, new), 4)))
+	AssertEqual(t, 0, len(GetDiffHunks(DiffLines(old, old), 1)))
+}
+
+func TestDiffLinesOverCellLimit(t *testing.T) {
+	defer func(cells int) { maxDiffCells = cells }(maxDiffCells)
+	maxDiffCells = 4
+
+	lines := DiffLines([]string{"a", "b", "c", "d"}, []string{"a", "c", "b", "d"})
+	var result []string
+	for _, line := range lines {
+		result = append(result, line.String())
+	}
+	AssertEqual(t, "  a|- b|- c|+ c|+ b|  d", strings.Join(result, "|"))
+}